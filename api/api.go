@@ -23,6 +23,9 @@ const (
 	RIOT_API_BASE_URL_FORMAT = ".api.riotgames.com"
 )
 
+// Version of the spec used to generate the clients and models, can be used as a cache namespace.
+const SPEC_VERSION = "c5f59a3e27f5101b78b8c7eb9b3fb88318b4225d"
+
 // Options to be used when executing requests.
 type ExecuteOptions bool
 
@@ -54,6 +57,13 @@ var (
 	ErrGatewayTimeout       = errors.New("gateway timeout")
 )
 
+var (
+	// Returned when a parameter is outside of its documented range, before sending the request.
+	ErrInvalidParameter = errors.New("invalid parameter")
+	// Returned when parsing a string that isn't a known route.
+	ErrInvalidRoute = errors.New("invalid route")
+)
+
 func StatusCodeToError(statusCode int) error {
 	switch statusCode {
 	case http.StatusBadRequest:
@@ -0,0 +1,29 @@
+package api
+
+// Batch configuration object, used by helpers such as lol.MatchV5.BatchByID.
+type BatchOptions struct {
+	// Maximum number of IDs fetched at a time, defaults to 1.
+	//
+	// An ID only starts when the rate limiter has a request left for it, so fewer IDs may be fetched at a time.
+	Concurrency int
+	// Called after every result is received with the current progress, never concurrently.
+	//
+	// If the context is cancelled, results not received are dropped and not counted.
+	Progress func(BatchProgress)
+}
+
+// Progress of a batch.
+type BatchProgress struct {
+	// Number of IDs in the batch, 0 if the IDs come from a channel.
+	Total int
+	// Number of IDs finished, including failures.
+	Done   int
+	Failed int
+}
+
+// Result of a single ID in a batch.
+type BatchResult[T any] struct {
+	ID   string
+	Data T
+	Err  error
+}
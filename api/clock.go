@@ -0,0 +1,21 @@
+package api
+
+import "time"
+
+// Source of time for rate limit and retry waits, replaceable in tests to avoid sleeping.
+type Clock interface {
+	Now() time.Time
+	// Returns a channel that receives the current time after the duration.
+	After(duration time.Duration) <-chan time.Time
+}
+
+// Clock using the time package, used when none is provided.
+type SystemClock struct{}
+
+func (SystemClock) Now() time.Time {
+	return time.Now()
+}
+
+func (SystemClock) After(duration time.Duration) <-chan time.Time {
+	return time.After(duration)
+}
@@ -1,9 +1,12 @@
 package api
 
 import (
+	"io"
+	"log/slog"
 	"time"
 
 	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel/trace"
 )
 
 // Configuration for the equinox client.
@@ -14,6 +17,14 @@ type EquinoxConfig struct {
 	Logger Logger
 	// Maximum number of retries, Jitter.
 	Retry Retry
+	// OpenTelemetry tracer provider, every API call creates a span named after its method ID. nil disables tracing.
+	TracerProvider trace.TracerProvider
+	// Receives request, rate limit, retry and cache measurements. nil disables metrics.
+	Metrics Metrics
+	// Callbacks for rate limit, retry and cache events. nil disables them.
+	Listeners *Listeners
+	// Used for rate limit and retry waits. nil uses SystemClock.
+	Clock Clock
 }
 
 // Retry configuration object.
@@ -27,7 +38,16 @@ type Retry struct {
 }
 
 // Logger configuration object.
+//
+// Logs are written to Writer by default, Handler or Zerolog can be used instead, in that order of precedence.
 type Logger struct {
+	// Sends logs to a slog.Handler, Level still applies, Pretty, TimeFieldFormat and EnableTimestamp are ignored.
+	Handler slog.Handler
+	// Uses an existing zerolog.Logger as the base logger, Level, Pretty, TimeFieldFormat and EnableTimestamp are ignored.
+	Zerolog *zerolog.Logger
+	// Output of the logger, defaults to os.Stderr.
+	Writer io.Writer
+	// Format of the timestamp, such as zerolog.TimeFormatUnix or time.RFC3339, zerolog's global TimeFieldFormat is not modified.
 	TimeFieldFormat string
 	Level           zerolog.Level
 	// Enables prettified logging.
@@ -1,5 +1,10 @@
 package api
 
+import (
+	"fmt"
+	"strings"
+)
+
 ///////////////////////////////////////////////
 //                                           //
 //                     !                     //
@@ -52,3 +57,25 @@ func (route RegionalRoute) String() string {
 		return string(route)
 	}
 }
+
+// Returns the RegionalRoute matching the string, case-insensitive.
+func ParseRegionalRoute(route string) (RegionalRoute, error) {
+	switch strings.ToLower(route) {
+	case "americas":
+		return AMERICAS, nil
+	case "apac":
+		return APAC, nil
+	case "asia":
+		return ASIA, nil
+	case "esports":
+		return ESPORTS, nil
+	case "esportseu":
+		return ESPORTSEU, nil
+	case "europe":
+		return EUROPE, nil
+	case "sea":
+		return SEA, nil
+	default:
+		return "", fmt.Errorf("%w: unknown regional route '%s'", ErrInvalidRoute, route)
+	}
+}
@@ -0,0 +1,92 @@
+package api
+
+import "time"
+
+// Callbacks for rate limit, retry and cache events, any of them can be nil.
+//
+// Callbacks are called synchronously and never while the rate limit store is locked. OnRateLimited is called while holding the limit
+// it waits on, it must not block or make requests to the same route with the same client.
+type Listeners struct {
+	// Called before waiting on a rate limited bucket or a RetryAfter delay.
+	OnRateLimited func(RateLimitedEvent)
+	// Called before waiting to retry a request.
+	OnRetry func(RetryEvent)
+	// Called when a response is served from the cache.
+	OnCacheHit func(CacheHitEvent)
+	// Called when the limits of a route change or a RetryAfter delay is set by a 429 response.
+	OnLimitsChanged func(LimitsChangedEvent)
+}
+
+type RateLimitedEvent struct {
+	Route    string
+	MethodID string
+	// 'application' or 'method'.
+	LimitType string
+	Wait      time.Duration
+	// True if waiting on a RetryAfter delay, Limit and Interval are 0.
+	RetryAfter bool
+	// Limit and interval of the rate limited bucket.
+	Limit    int
+	Interval time.Duration
+}
+
+type RetryEvent struct {
+	Route      string
+	MethodID   string
+	StatusCode int
+	// Number of the retry, starting at 1.
+	Attempt int
+	Wait    time.Duration
+	// Error of the previous attempt.
+	Err error
+}
+
+type CacheHitEvent struct {
+	Route    string
+	MethodID string
+	// True if a remembered 404 response was served.
+	NotFound bool
+}
+
+type LimitsChangedEvent struct {
+	Route    string
+	MethodID string
+	// 'application' or 'method'.
+	LimitType string
+	// New buckets, nil if only RetryAfter was set.
+	Buckets []BucketLimit
+	// Delay set by a 429 response, 0 if the limits changed.
+	RetryAfter time.Duration
+}
+
+type BucketLimit struct {
+	// Limit given in the header.
+	BaseLimit int
+	// Limit modified by the LimitUsageFactor.
+	Limit    int
+	Interval time.Duration
+}
+
+func (l *Listeners) EmitRateLimited(event RateLimitedEvent) {
+	if l != nil && l.OnRateLimited != nil {
+		l.OnRateLimited(event)
+	}
+}
+
+func (l *Listeners) EmitRetry(event RetryEvent) {
+	if l != nil && l.OnRetry != nil {
+		l.OnRetry(event)
+	}
+}
+
+func (l *Listeners) EmitCacheHit(event CacheHitEvent) {
+	if l != nil && l.OnCacheHit != nil {
+		l.OnCacheHit(event)
+	}
+}
+
+func (l *Listeners) EmitLimitsChanged(event LimitsChangedEvent) {
+	if l != nil && l.OnLimitsChanged != nil {
+		l.OnLimitsChanged(event)
+	}
+}
@@ -0,0 +1,12 @@
+package api
+
+// Ladder configuration object, used by helpers such as lol.LeagueV4.Ladder.
+type LadderOptions struct {
+	// Maximum number of tiers/divisions requested at the same time, defaults to DEFAULT_LADDER_CONCURRENCY.
+	//
+	// A tier/division only starts when the rate limiter has a request left for it, so the ladder never runs more than the rate limit allows.
+	// Tiers/divisions ahead of the one being yielded buffer a single page, pages are requested as they are yielded.
+	Concurrency int
+}
+
+const DEFAULT_LADDER_CONCURRENCY = 4
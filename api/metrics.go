@@ -0,0 +1,23 @@
+package api
+
+import "time"
+
+// Receives measurements from the client and rate limiter, see the metrics package for Prometheus and OpenTelemetry adapters.
+//
+// Implementations must be safe for concurrent use.
+type Metrics interface {
+	// Called after every HTTP round trip, including retries. statusCode is 0 if the http.Client returned an error.
+	ObserveRequest(methodID string, route string, statusCode int, duration time.Duration)
+	// Called after waiting on the rate limiter, wait is 0 if the request was not delayed.
+	ObserveRateLimitWait(methodID string, route string, wait time.Duration)
+	// Called before a request is retried.
+	IncRetry(methodID string, route string)
+	// Called on a 429 response, limitType is the 'X-Rate-Limit-Type' header, empty if not present.
+	IncRateLimited(methodID string, route string, limitType string)
+	// Called after a cache lookup, a remembered 404 response counts as a hit.
+	IncCacheLookup(methodID string, hit bool)
+	// Called after an item is saved in the cache, bytes is the size of the item written. Adapters export it as a counter of bytes set, not the size of the cache.
+	IncCacheSet(methodID string, bytes int)
+	// Called when a cache lookup or set fails.
+	IncCacheError(methodID string)
+}
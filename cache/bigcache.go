@@ -2,6 +2,7 @@ package cache
 
 import (
 	"context"
+	"strings"
 
 	"github.com/allegro/bigcache/v3"
 )
@@ -29,3 +30,24 @@ func (s BigCacheStore) Delete(_ctx context.Context, key string) error {
 func (s BigCacheStore) Clear(_ctx context.Context) error {
 	return s.client.Reset()
 }
+
+func (s BigCacheStore) DeleteBySuffix(_ctx context.Context, suffix string) error {
+	matches := make([]string, 0, 1)
+	iter := s.client.Iterator()
+	for iter.SetNext() {
+		entry, err := iter.Value()
+		if err != nil {
+			return err
+		}
+		if strings.HasSuffix(entry.Key(), suffix) {
+			matches = append(matches, entry.Key())
+		}
+	}
+	for _, key := range matches {
+		err := s.client.Delete(key)
+		if err != nil && err != bigcache.ErrEntryNotFound {
+			return err
+		}
+	}
+	return nil
+}
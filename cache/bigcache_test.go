@@ -64,3 +64,38 @@ func TestBigCacheMethods(t *testing.T) {
 	require.NoError(t, err)
 	require.Empty(t, retrievedData)
 }
+
+func TestBigCacheInvalidateAccessToken(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	c, err := cache.NewBigCache(ctx, bigcache.DefaultConfig(4*time.Minute))
+	require.NoError(t, err)
+
+	response := []byte("{data: 123}")
+
+	tokenKey, isRSO := cache.GetCacheKey("https://americas.api.riotgames.com/riot/account/v1/accounts/me", "Bearer token")
+	require.True(t, isRSO)
+	otherTokenKey, _ := cache.GetCacheKey("https://americas.api.riotgames.com/riot/account/v1/accounts/me", "Bearer other")
+	key, _ := cache.GetCacheKey("https://euw1.api.riotgames.com", "")
+
+	for _, k := range []string{tokenKey, otherTokenKey, key} {
+		err = c.Set(ctx, k, response)
+		require.NoError(t, err)
+	}
+
+	err = c.InvalidateAccessToken(ctx, "token")
+	require.NoError(t, err)
+
+	item, err := c.Get(ctx, tokenKey)
+	require.NoError(t, err)
+	require.Nil(t, item)
+
+	item, err = c.Get(ctx, otherTokenKey)
+	require.NoError(t, err)
+	require.Equal(t, response, item)
+
+	item, err = c.Get(ctx, key)
+	require.NoError(t, err)
+	require.Equal(t, response, item)
+}
@@ -4,10 +4,14 @@ package cache
 import (
 	"context"
 	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
 	"errors"
-	"fmt"
+	"net/url"
+	"sync"
 	"time"
 
+	"github.com/Kyagara/equinox/v2/api"
 	"github.com/allegro/bigcache/v3"
 	"github.com/redis/go-redis/v9"
 )
@@ -15,6 +19,8 @@ import (
 var (
 	ErrCacheIsDisabled = errors.New("cache is disabled")
 	ErrRedisOptionsNil = errors.New("redis options is nil")
+	// Returned by InvalidateAccessToken when the Store doesn't implement SuffixDeleter.
+	ErrSuffixDeleteUnsupported = errors.New("store does not support deleting by suffix")
 )
 
 type StoreType string
@@ -22,14 +28,31 @@ type StoreType string
 const (
 	BigCache   StoreType = "BigCache"
 	RedisCache StoreType = "Redis"
+	DiskCache  StoreType = "Disk"
+
+	// Prefix added to every key in Redis by NewRedis.
+	DEFAULT_REDIS_NAMESPACE = "equinox:cache"
 )
 
 type Cache struct {
 	store     Store
 	StoreType StoreType
 	TTL       time.Duration
+	// Function used by Key to create keys, defaults to GetCacheKey.
+	KeyFunc KeyFunc
+	// Prefix added by Key to every key, e.g. api.SPEC_VERSION so entries are invalidated when models change.
+	Namespace string
+	// Duration a 404 response is remembered for, 0 disables negative caching. Capped by TTL.
+	NotFoundTTL time.Duration
+	// Usage counters keyed by MethodID, see Stats().
+	stats sync.Map
 }
 
+// Creates a key from a normalized URL and the 'Authorization' header, returning if the key has an accessToken hash.
+//
+// InvalidateAccessToken only works with keys ending with the accessToken hash, like the ones created by GetCacheKey.
+type KeyFunc func(url string, authHeader string) (string, bool)
+
 type Store interface {
 	// Returns an item from the cache. If no item is found, returns nil for the item and error.
 	Get(ctx context.Context, key string) ([]byte, error)
@@ -42,10 +65,16 @@ type Store interface {
 
 	// Clears the entire cache.
 	//
-	// For Redis, the entire 'cache' namespace under 'equinox' is deleted.
+	// For Redis, every key under the namespace is deleted, 'equinox:cache' by default.
 	Clear(ctx context.Context) error
 }
 
+// Optional interface for a Store, required by InvalidateAccessToken.
+type SuffixDeleter interface {
+	// Deletes every item whose key ends with the suffix provided.
+	DeleteBySuffix(ctx context.Context, suffix string) error
+}
+
 // Creates a new Cache using BigCache.
 //
 // Requires a BigCache config that can be created with bigcache.DefaultConfig(n*time.Minute).
@@ -62,8 +91,15 @@ func NewBigCache(ctx context.Context, config bigcache.Config) (*Cache, error) {
 	return cache, nil
 }
 
-// Creates a new Cache using go-redis.
+// Creates a new Cache using go-redis, keys are prefixed with DEFAULT_REDIS_NAMESPACE.
 func NewRedis(ctx context.Context, options *redis.Options, ttl time.Duration) (*Cache, error) {
+	return NewRedisWithNamespace(ctx, options, ttl, DEFAULT_REDIS_NAMESPACE)
+}
+
+// Same as NewRedis, keys are prefixed with the namespace provided instead, e.g. "myapp:equinox".
+//
+// Separate namespaces allow multiple applications to share a Redis instance without clearing each other's cache.
+func NewRedisWithNamespace(ctx context.Context, options *redis.Options, ttl time.Duration, namespace string) (*Cache, error) {
 	if options == nil {
 		return nil, ErrRedisOptionsNil
 	}
@@ -76,7 +112,7 @@ func NewRedis(ctx context.Context, options *redis.Options, ttl time.Duration) (*
 		store: RedisStore{
 			client:    redis,
 			ttl:       ttl,
-			namespace: "equinox:cache",
+			namespace: namespace,
 		},
 		TTL:       ttl,
 		StoreType: RedisCache,
@@ -84,11 +120,37 @@ func NewRedis(ctx context.Context, options *redis.Options, ttl time.Duration) (*
 	return cache, nil
 }
 
+// Creates a new Cache persisted in a directory, items survive process restarts.
+//
+// When the total size of items exceeds maxSize, in bytes, the least recently used items are evicted, 0 disables the limit.
+func NewDisk(ctx context.Context, dir string, ttl time.Duration, maxSize int64) (*Cache, error) {
+	store, err := NewDiskStore(dir, ttl, maxSize)
+	if err != nil {
+		return nil, err
+	}
+	cache := &Cache{
+		store:     store,
+		TTL:       ttl,
+		StoreType: DiskCache,
+	}
+	return cache, nil
+}
+
+// Returns an item from the cache, nil if not found.
+//
+// If the key has a 404 response remembered by SetNotFound, returns api.ErrNotFound.
 func (c *Cache) Get(ctx context.Context, key string) ([]byte, error) {
 	if c.TTL == 0 {
 		return nil, ErrCacheIsDisabled
 	}
-	return c.store.Get(ctx, key)
+	item, err := c.store.Get(ctx, key)
+	if err != nil || !isNotFoundItem(item) {
+		return item, err
+	}
+	if time.Now().After(decodeNotFoundItem(item)) {
+		return nil, c.store.Delete(ctx, key)
+	}
+	return nil, api.ErrNotFound
 }
 
 func (c *Cache) Set(ctx context.Context, key string, item []byte) error {
@@ -112,6 +174,56 @@ func (c *Cache) Clear(ctx context.Context) error {
 	return c.store.Clear(ctx)
 }
 
+// Remembers a 404 response for the key provided during NotFoundTTL, capped by TTL, does nothing if negative caching is disabled.
+func (c *Cache) SetNotFound(ctx context.Context, key string) error {
+	if c.TTL == 0 {
+		return ErrCacheIsDisabled
+	}
+	if c.NotFoundTTL <= 0 {
+		return nil
+	}
+	ttl := min(c.NotFoundTTL, c.TTL)
+	return c.store.Set(ctx, key, encodeNotFoundItem(time.Now().Add(ttl)))
+}
+
+// Deletes every cached item derived from the accessToken provided, e.g. after a user logs out.
+//
+// Returns ErrSuffixDeleteUnsupported if the Store doesn't implement SuffixDeleter.
+func (c *Cache) InvalidateAccessToken(ctx context.Context, accessToken string) error {
+	if c.TTL == 0 {
+		return ErrCacheIsDisabled
+	}
+	deleter, ok := c.store.(SuffixDeleter)
+	if !ok {
+		return ErrSuffixDeleteUnsupported
+	}
+	suffix := "-" + hashAuthHeader("Bearer "+accessToken)
+	return deleter.DeleteBySuffix(ctx, suffix)
+}
+
+// Returns the Cache key for a request URL, query parameters are sorted so equivalent requests share the same key.
+func (c *Cache) Key(url *url.URL, authHeader string) string {
+	keyFunc := c.KeyFunc
+	if keyFunc == nil {
+		keyFunc = GetCacheKey
+	}
+	key, _ := keyFunc(NormalizeURL(url), authHeader)
+	if c.Namespace != "" {
+		return c.Namespace + ":" + key
+	}
+	return key
+}
+
+// Returns the URL as a string with its query parameters sorted by key.
+func NormalizeURL(url *url.URL) string {
+	if url.RawQuery == "" {
+		return url.String()
+	}
+	normalized := *url
+	normalized.RawQuery = url.Query().Encode()
+	return normalized.String()
+}
+
 // Returns the Cache key for the provided URL and a bool indicating if the key has an accessToken hash. Most of the time this will just return the URL.
 func GetCacheKey(url string, authHeader string) (string, bool) {
 	// I plan to use xxhash instead of sha256 in the future since it is already imported by `go-redis`.
@@ -136,9 +248,29 @@ func GetCacheKey(url string, authHeader string) (string, bool) {
 		return url, false
 	}
 
-	hash := sha256.New()
-	_, _ = hash.Write([]byte(authHeader))
-	hashedAuth := hash.Sum(nil)
+	return url + "-" + hashAuthHeader(authHeader), true
+}
+
+// Returns the hex encoded SHA-256 hash of the 'Authorization' header.
+func hashAuthHeader(authHeader string) string {
+	hash := sha256.Sum256([]byte(authHeader))
+	return hex.EncodeToString(hash[:])
+}
+
+// Prefix used for 404 responses, followed by the expiration in unix nanoseconds. Valid json never starts with a NUL byte.
+const notFoundPrefix = "\x00404"
+
+func encodeNotFoundItem(expires time.Time) []byte {
+	item := make([]byte, len(notFoundPrefix)+8)
+	copy(item, notFoundPrefix)
+	binary.BigEndian.PutUint64(item[len(notFoundPrefix):], uint64(expires.UnixNano()))
+	return item
+}
+
+func isNotFoundItem(item []byte) bool {
+	return len(item) == len(notFoundPrefix)+8 && string(item[:len(notFoundPrefix)]) == notFoundPrefix
+}
 
-	return fmt.Sprintf("%s-%x", url, hashedAuth), true
+func decodeNotFoundItem(item []byte) time.Time {
+	return time.Unix(0, int64(binary.BigEndian.Uint64(item[len(notFoundPrefix):])))
 }
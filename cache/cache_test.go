@@ -5,12 +5,21 @@ import (
 	"net/http"
 	"net/url"
 	"testing"
+	"time"
 
 	"github.com/Kyagara/equinox/v2/api"
 	"github.com/Kyagara/equinox/v2/cache"
+	"github.com/allegro/bigcache/v3"
 	"github.com/stretchr/testify/require"
 )
 
+// Built-in stores support InvalidateAccessToken.
+var (
+	_ cache.SuffixDeleter = cache.BigCacheStore{}
+	_ cache.SuffixDeleter = cache.RedisStore{}
+	_ cache.SuffixDeleter = &cache.DiskStore{}
+)
+
 func TestCacheMethods(t *testing.T) {
 	t.Parallel()
 
@@ -31,6 +40,58 @@ func TestCacheMethods(t *testing.T) {
 	require.Equal(t, cache.ErrCacheIsDisabled, err)
 	err = cacheStore.Clear(ctx)
 	require.Equal(t, cache.ErrCacheIsDisabled, err)
+	err = cacheStore.InvalidateAccessToken(ctx, "token")
+	require.Equal(t, cache.ErrCacheIsDisabled, err)
+	err = cacheStore.SetNotFound(ctx, key)
+	require.Equal(t, cache.ErrCacheIsDisabled, err)
+}
+
+func TestCacheNotFound(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	c, err := cache.NewBigCache(ctx, bigcache.DefaultConfig(4*time.Minute))
+	require.NoError(t, err)
+
+	key := "https://euw1.api.riotgames.com/lol/spectator/v5/active-games/by-summoner/puuid"
+
+	// Negative caching is disabled
+	err = c.SetNotFound(ctx, key)
+	require.NoError(t, err)
+	item, err := c.Get(ctx, key)
+	require.NoError(t, err)
+	require.Nil(t, item)
+
+	c.NotFoundTTL = 10 * time.Millisecond
+
+	err = c.SetNotFound(ctx, key)
+	require.NoError(t, err)
+	item, err = c.Get(ctx, key)
+	require.Equal(t, api.ErrNotFound, err)
+	require.Nil(t, item)
+
+	// Counts as a hit
+	_, err = c.GetWithStats(ctx, "spectator-v5.getCurrentGameInfoByPuuid", key)
+	require.Equal(t, api.ErrNotFound, err)
+	require.Equal(t, cache.Stats{Hits: 1}, c.Stats()["spectator-v5.getCurrentGameInfoByPuuid"])
+
+	time.Sleep(20 * time.Millisecond)
+
+	// Expired, item is deleted
+	item, err = c.Get(ctx, key)
+	require.NoError(t, err)
+	require.Nil(t, item)
+
+	// Capped by TTL
+	c.TTL = 10 * time.Millisecond
+	c.NotFoundTTL = time.Hour
+
+	err = c.SetNotFound(ctx, key)
+	require.NoError(t, err)
+	time.Sleep(20 * time.Millisecond)
+	item, err = c.Get(ctx, key)
+	require.NoError(t, err)
+	require.Nil(t, item)
 }
 
 func TestGetCacheKey(t *testing.T) {
@@ -57,3 +118,38 @@ func TestGetCacheKey(t *testing.T) {
 	require.Equal(t, "http://example.com/path-ec2cc2a7cbc79c8d8def89cb9b9a1bccf4c2efc56a9c8063f9f4ae806f08c4d7", hash)
 	require.True(t, isRSO)
 }
+
+func TestCacheKey(t *testing.T) {
+	t.Parallel()
+
+	c := &cache.Cache{}
+
+	a, err := url.Parse("https://americas.api.riotgames.com/lol/match/v5/matches/by-puuid/puuid/ids?start=0&count=100")
+	require.NoError(t, err)
+	b, err := url.Parse("https://americas.api.riotgames.com/lol/match/v5/matches/by-puuid/puuid/ids?count=100&start=0")
+	require.NoError(t, err)
+	other, err := url.Parse("https://americas.api.riotgames.com/lol/match/v5/matches/by-puuid/puuid/ids?count=100&start=100")
+	require.NoError(t, err)
+
+	// Query parameters order is ignored
+	require.Equal(t, "https://americas.api.riotgames.com/lol/match/v5/matches/by-puuid/puuid/ids?count=100&start=0", c.Key(a, ""))
+	require.Equal(t, c.Key(a, ""), c.Key(b, ""))
+	require.NotEqual(t, c.Key(a, ""), c.Key(other, ""))
+
+	// Uses the accessToken hash, like GetCacheKey
+	key, _ := cache.GetCacheKey(cache.NormalizeURL(a), "Bearer token")
+	require.Equal(t, key, c.Key(b, "Bearer token"))
+
+	c.Namespace = api.SPEC_VERSION
+	require.Equal(t, api.SPEC_VERSION+":"+key, c.Key(a, "Bearer token"))
+
+	c.KeyFunc = func(url string, authHeader string) (string, bool) {
+		return "custom:" + url, authHeader != ""
+	}
+	require.Equal(t, api.SPEC_VERSION+":custom:https://americas.api.riotgames.com/lol/match/v5/matches/by-puuid/puuid/ids?count=100&start=0", c.Key(b, ""))
+
+	// No query parameters
+	u, err := url.Parse("https://euw1.api.riotgames.com/lol/status/v4/platform-data")
+	require.NoError(t, err)
+	require.Equal(t, "https://euw1.api.riotgames.com/lol/status/v4/platform-data", cache.NormalizeURL(u))
+}
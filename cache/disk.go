@@ -0,0 +1,344 @@
+package cache
+
+import (
+	"container/list"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	ErrInvalidDiskItem = errors.New("invalid disk cache item")
+)
+
+// Size of the item header, expiration in unix nanoseconds followed by the key length.
+const diskHeaderSize = 8 + 4
+
+// Cache Store persisted in a directory, each item is saved in a file named after the SHA-256 hash of its key.
+//
+// Only one process should use a directory at a time, the index used for expiration and eviction is kept in memory.
+type DiskStore struct {
+	entries map[string]*list.Element
+	// Most recently used items are at the front.
+	lru     *list.List
+	dir     string
+	ttl     time.Duration
+	maxSize int64
+	size    int64
+	mutex   sync.Mutex
+}
+
+type diskEntry struct {
+	expires time.Time
+	name    string
+	key     string
+	size    int64
+}
+
+// Creates a new DiskStore, items already in the directory are indexed and expired items are removed.
+//
+// When the total size of items exceeds maxSize, in bytes, the least recently used items are evicted, 0 disables the limit.
+func NewDiskStore(dir string, ttl time.Duration, maxSize int64) (*DiskStore, error) {
+	err := os.MkdirAll(dir, 0o755)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &DiskStore{
+		entries: make(map[string]*list.Element, 1),
+		lru:     list.New(),
+		dir:     dir,
+		ttl:     ttl,
+		maxSize: maxSize,
+	}
+
+	err = s.load()
+	if err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+func (s *DiskStore) Get(_ctx context.Context, key string) ([]byte, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	name := diskFileName(key)
+	element, ok := s.entries[name]
+	if !ok {
+		return nil, nil
+	}
+
+	entry := element.Value.(*diskEntry)
+	now := time.Now()
+	if now.After(entry.expires) {
+		return nil, s.remove(element)
+	}
+
+	content, err := os.ReadFile(filepath.Join(s.dir, name))
+	if errors.Is(err, fs.ErrNotExist) {
+		s.lru.Remove(element)
+		delete(s.entries, name)
+		s.size -= entry.size
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	_, _, value, err := decodeDiskItem(content)
+	if err != nil {
+		return nil, err
+	}
+
+	// Modification time is used to restore the LRU order when loading the directory
+	_ = os.Chtimes(filepath.Join(s.dir, name), now, now)
+	s.lru.MoveToFront(element)
+	return value, nil
+}
+
+func (s *DiskStore) Set(_ctx context.Context, key string, value []byte) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	name := diskFileName(key)
+	expires := time.Now().Add(s.ttl)
+	content := encodeDiskItem(expires, key, value)
+
+	// Write to a temporary file first so a partially written item is never read
+	path := filepath.Join(s.dir, name)
+	tmp := path + ".tmp"
+	err := os.WriteFile(tmp, content, 0o644)
+	if err != nil {
+		return err
+	}
+	err = os.Rename(tmp, path)
+	if err != nil {
+		_ = os.Remove(tmp)
+		return err
+	}
+
+	size := int64(len(content))
+	if element, ok := s.entries[name]; ok {
+		entry := element.Value.(*diskEntry)
+		s.size += size - entry.size
+		entry.size = size
+		entry.expires = expires
+		s.lru.MoveToFront(element)
+	} else {
+		entry := &diskEntry{expires: expires, name: name, key: key, size: size}
+		s.entries[name] = s.lru.PushFront(entry)
+		s.size += size
+	}
+
+	return s.evict()
+}
+
+func (s *DiskStore) Delete(_ctx context.Context, key string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	element, ok := s.entries[diskFileName(key)]
+	if !ok {
+		return nil
+	}
+	return s.remove(element)
+}
+
+func (s *DiskStore) DeleteBySuffix(_ctx context.Context, suffix string) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for _, element := range s.entries {
+		if strings.HasSuffix(element.Value.(*diskEntry).key, suffix) {
+			err := s.remove(element)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (s *DiskStore) Clear(_ctx context.Context) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for _, element := range s.entries {
+		err := s.remove(element)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Removes the least recently used items until the total size is within maxSize.
+func (s *DiskStore) evict() error {
+	if s.maxSize <= 0 {
+		return nil
+	}
+
+	for s.size > s.maxSize {
+		element := s.lru.Back()
+		if element == nil {
+			return nil
+		}
+		err := s.remove(element)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Removes the item file and its index entry.
+func (s *DiskStore) remove(element *list.Element) error {
+	entry := element.Value.(*diskEntry)
+	s.lru.Remove(element)
+	delete(s.entries, entry.name)
+	s.size -= entry.size
+
+	err := os.Remove(filepath.Join(s.dir, entry.name))
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// Indexes the items already in the directory, least recently used first.
+func (s *DiskStore) load() error {
+	files, err := os.ReadDir(s.dir)
+	if err != nil {
+		return err
+	}
+
+	type loaded struct {
+		modTime time.Time
+		entry   *diskEntry
+	}
+
+	now := time.Now()
+	items := make([]loaded, 0, len(files))
+
+	for _, file := range files {
+		name := file.Name()
+		path := filepath.Join(s.dir, name)
+
+		if strings.HasSuffix(name, ".tmp") {
+			_ = os.Remove(path)
+			continue
+		}
+
+		if file.IsDir() || !isDiskFileName(name) {
+			continue
+		}
+
+		info, err := file.Info()
+		if err != nil {
+			return err
+		}
+
+		expires, key, err := readDiskHeader(path, info.Size())
+		if errors.Is(err, ErrInvalidDiskItem) || (err == nil && now.After(expires)) {
+			_ = os.Remove(path)
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		items = append(items, loaded{
+			modTime: info.ModTime(),
+			entry:   &diskEntry{expires: expires, name: name, key: key, size: info.Size()},
+		})
+	}
+
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].modTime.Before(items[j].modTime)
+	})
+
+	for _, item := range items {
+		s.entries[item.entry.name] = s.lru.PushFront(item.entry)
+		s.size += item.entry.size
+	}
+
+	return s.evict()
+}
+
+func diskFileName(key string) string {
+	hash := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(hash[:])
+}
+
+func isDiskFileName(name string) bool {
+	if len(name) != sha256.Size*2 {
+		return false
+	}
+	_, err := hex.DecodeString(name)
+	return err == nil
+}
+
+func encodeDiskItem(expires time.Time, key string, value []byte) []byte {
+	content := make([]byte, diskHeaderSize, diskHeaderSize+len(key)+len(value))
+	binary.BigEndian.PutUint64(content[:8], uint64(expires.UnixNano()))
+	binary.BigEndian.PutUint32(content[8:diskHeaderSize], uint32(len(key)))
+	content = append(content, key...)
+	return append(content, value...)
+}
+
+// Reads the expiration and key of an item file without reading its value, size is the size of the file.
+func readDiskHeader(path string, size int64) (time.Time, string, error) {
+	if size < diskHeaderSize {
+		return time.Time{}, "", ErrInvalidDiskItem
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return time.Time{}, "", err
+	}
+	defer file.Close()
+
+	header := make([]byte, diskHeaderSize)
+	if _, err := io.ReadFull(file, header); err != nil {
+		return time.Time{}, "", err
+	}
+
+	expires := time.Unix(0, int64(binary.BigEndian.Uint64(header[:8])))
+	keyLength := int64(binary.BigEndian.Uint32(header[8:diskHeaderSize]))
+	if size < diskHeaderSize+keyLength {
+		return time.Time{}, "", ErrInvalidDiskItem
+	}
+
+	key := make([]byte, keyLength)
+	if _, err := io.ReadFull(file, key); err != nil {
+		return time.Time{}, "", err
+	}
+	return expires, string(key), nil
+}
+
+func decodeDiskItem(content []byte) (time.Time, string, []byte, error) {
+	if len(content) < diskHeaderSize {
+		return time.Time{}, "", nil, ErrInvalidDiskItem
+	}
+
+	expires := time.Unix(0, int64(binary.BigEndian.Uint64(content[:8])))
+	keyLength := int(binary.BigEndian.Uint32(content[8:diskHeaderSize]))
+	if len(content) < diskHeaderSize+keyLength {
+		return time.Time{}, "", nil, ErrInvalidDiskItem
+	}
+
+	key := string(content[diskHeaderSize : diskHeaderSize+keyLength])
+	return expires, key, content[diskHeaderSize+keyLength:], nil
+}
@@ -0,0 +1,198 @@
+package cache_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Kyagara/equinox/v2/cache"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDisk(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	dir := t.TempDir()
+	c, err := cache.NewDisk(ctx, dir, 4*time.Minute, 0)
+	require.NoError(t, err)
+	require.NotEmpty(t, c)
+	require.Equal(t, cache.DiskCache, c.StoreType)
+
+	// Path is a file
+	file := filepath.Join(dir, "file")
+	err = os.WriteFile(file, []byte{}, 0o644)
+	require.NoError(t, err)
+	c, err = cache.NewDisk(ctx, file, 4*time.Minute, 0)
+	require.Error(t, err)
+	require.Nil(t, c)
+}
+
+func TestDiskMethods(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	cache, err := cache.NewDisk(ctx, t.TempDir(), 4*time.Minute, 0)
+	require.NoError(t, err)
+	require.NotEmpty(t, cache)
+
+	// Data
+	key := "https://euw1.api.riotgames.com"
+	response := []byte("{data: 123}")
+
+	err = cache.Set(ctx, key, response)
+	require.NoError(t, err)
+
+	// Get on cached key
+	retrievedData, err := cache.Get(ctx, key)
+	require.NoError(t, err)
+	require.Equal(t, response, retrievedData)
+
+	err = cache.Delete(ctx, key)
+	require.NoError(t, err)
+
+	// Get on deleted key
+	retrievedData, err = cache.Get(ctx, key)
+	require.NoError(t, err)
+	require.Empty(t, retrievedData)
+
+	err = cache.Set(ctx, key, response)
+	require.NoError(t, err)
+
+	err = cache.Clear(ctx)
+	require.NoError(t, err)
+
+	// Get on cleared cache
+	retrievedData, err = cache.Get(ctx, key)
+	require.NoError(t, err)
+	require.Empty(t, retrievedData)
+}
+
+func TestDiskPersistence(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	dir := t.TempDir()
+	c, err := cache.NewDisk(ctx, dir, 4*time.Minute, 0)
+	require.NoError(t, err)
+
+	key := "https://asia.api.riotgames.com/lol/match/v5/matches/KR_7014499581"
+	response := []byte(`{"metadata":{}}`)
+
+	err = c.Set(ctx, key, response)
+	require.NoError(t, err)
+
+	// Simulating a restart
+	c, err = cache.NewDisk(ctx, dir, 4*time.Minute, 0)
+	require.NoError(t, err)
+
+	retrievedData, err := c.Get(ctx, key)
+	require.NoError(t, err)
+	require.Equal(t, response, retrievedData)
+
+	// Access token entries are also indexed after a restart
+	tokenKey, _ := cache.GetCacheKey(key, "Bearer token")
+	err = c.Set(ctx, tokenKey, response)
+	require.NoError(t, err)
+
+	c, err = cache.NewDisk(ctx, dir, 4*time.Minute, 0)
+	require.NoError(t, err)
+
+	err = c.InvalidateAccessToken(ctx, "token")
+	require.NoError(t, err)
+
+	retrievedData, err = c.Get(ctx, tokenKey)
+	require.NoError(t, err)
+	require.Nil(t, retrievedData)
+
+	retrievedData, err = c.Get(ctx, key)
+	require.NoError(t, err)
+	require.Equal(t, response, retrievedData)
+
+	// Truncated items are removed on startup
+	truncated := filepath.Join(dir, strings.Repeat("0", 64))
+	require.NoError(t, os.WriteFile(truncated, []byte{0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 1, 0}, 0o644))
+
+	_, err = cache.NewDisk(ctx, dir, 4*time.Minute, 0)
+	require.NoError(t, err)
+	require.NoFileExists(t, truncated)
+}
+
+func TestDiskExpiration(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	dir := t.TempDir()
+	c, err := cache.NewDisk(ctx, dir, time.Millisecond, 0)
+	require.NoError(t, err)
+
+	err = c.Set(ctx, "a", []byte("a"))
+	require.NoError(t, err)
+	err = c.Set(ctx, "b", []byte("b"))
+	require.NoError(t, err)
+
+	time.Sleep(5 * time.Millisecond)
+
+	retrievedData, err := c.Get(ctx, "a")
+	require.NoError(t, err)
+	require.Nil(t, retrievedData)
+
+	// Expired items are removed when loading the directory
+	_, err = cache.NewDisk(ctx, dir, time.Millisecond, 0)
+	require.NoError(t, err)
+
+	files, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Empty(t, files)
+}
+
+func TestDiskEviction(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	// Each item takes 12 bytes of header, 1 byte of key and 10 bytes of value
+	value := []byte("0123456789")
+	c, err := cache.NewDisk(ctx, dir, 4*time.Minute, 23*2)
+	require.NoError(t, err)
+
+	err = c.Set(ctx, "a", value)
+	require.NoError(t, err)
+	err = c.Set(ctx, "b", value)
+	require.NoError(t, err)
+
+	// 'a' is now the most recently used
+	retrievedData, err := c.Get(ctx, "a")
+	require.NoError(t, err)
+	require.Equal(t, value, retrievedData)
+
+	// Evicts 'b'
+	err = c.Set(ctx, "c", value)
+	require.NoError(t, err)
+
+	retrievedData, err = c.Get(ctx, "b")
+	require.NoError(t, err)
+	require.Nil(t, retrievedData)
+
+	for _, key := range []string{"a", "c"} {
+		retrievedData, err = c.Get(ctx, key)
+		require.NoError(t, err)
+		require.Equal(t, value, retrievedData)
+	}
+
+	files, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, files, 2)
+
+	// A smaller limit evicts items when loading the directory
+	c, err = cache.NewDisk(ctx, dir, 4*time.Minute, 23)
+	require.NoError(t, err)
+
+	files, err = os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+}
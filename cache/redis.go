@@ -41,3 +41,20 @@ func (s RedisStore) Clear(ctx context.Context) error {
 	cache := strings.Join(keys, ":")
 	return s.client.Del(ctx, cache).Err()
 }
+
+func (s RedisStore) DeleteBySuffix(ctx context.Context, suffix string) error {
+	keys := []string{s.namespace, "*" + suffix}
+	pattern := strings.Join(keys, ":")
+	iter := s.client.Scan(ctx, 0, pattern, 0).Iterator()
+	matches := make([]string, 0, 1)
+	for iter.Next(ctx) {
+		matches = append(matches, iter.Val())
+	}
+	if err := iter.Err(); err != nil {
+		return err
+	}
+	if len(matches) == 0 {
+		return nil
+	}
+	return s.client.Del(ctx, matches...).Err()
+}
@@ -75,3 +75,84 @@ func TestRedisMethods(t *testing.T) {
 	require.NoError(t, err)
 	require.Empty(t, retrievedData)
 }
+
+func TestRedisInvalidateAccessToken(t *testing.T) {
+	t.Parallel()
+
+	s := miniredis.RunT(t)
+	ctx := context.Background()
+	config := &redis.Options{
+		Network: "tcp",
+		Addr:    s.Addr(),
+	}
+
+	c, err := cache.NewRedis(ctx, config, 4*time.Minute)
+	require.NoError(t, err)
+
+	response := []byte("{data: 123}")
+
+	tokenKey, isRSO := cache.GetCacheKey("https://americas.api.riotgames.com/riot/account/v1/accounts/me", "Bearer token")
+	require.True(t, isRSO)
+	otherTokenKey, _ := cache.GetCacheKey("https://americas.api.riotgames.com/riot/account/v1/accounts/me", "Bearer other")
+	key, _ := cache.GetCacheKey("https://euw1.api.riotgames.com", "")
+
+	for _, k := range []string{tokenKey, otherTokenKey, key} {
+		err = c.Set(ctx, k, response)
+		require.NoError(t, err)
+	}
+
+	err = c.InvalidateAccessToken(ctx, "token")
+	require.NoError(t, err)
+
+	item, err := c.Get(ctx, tokenKey)
+	require.NoError(t, err)
+	require.Nil(t, item)
+
+	item, err = c.Get(ctx, otherTokenKey)
+	require.NoError(t, err)
+	require.Equal(t, response, item)
+
+	item, err = c.Get(ctx, key)
+	require.NoError(t, err)
+	require.Equal(t, response, item)
+
+	// Nothing left to delete
+	err = c.InvalidateAccessToken(ctx, "token")
+	require.NoError(t, err)
+}
+
+func TestRedisNamespace(t *testing.T) {
+	t.Parallel()
+
+	s := miniredis.RunT(t)
+	ctx := context.Background()
+	config := &redis.Options{
+		Network: "tcp",
+		Addr:    s.Addr(),
+	}
+
+	_, err := cache.NewRedisWithNamespace(ctx, nil, 4*time.Minute, "myapp")
+	require.Equal(t, cache.ErrRedisOptionsNil, err)
+
+	c, err := cache.NewRedisWithNamespace(ctx, config, 4*time.Minute, "myapp")
+	require.NoError(t, err)
+	defaultCache, err := cache.NewRedis(ctx, config, 4*time.Minute)
+	require.NoError(t, err)
+
+	key := "https://euw1.api.riotgames.com"
+	response := []byte("{data: 123}")
+
+	err = c.Set(ctx, key, response)
+	require.NoError(t, err)
+	require.True(t, s.Exists("myapp:"+key))
+	require.False(t, s.Exists(cache.DEFAULT_REDIS_NAMESPACE+":"+key))
+
+	// Not shared with the default namespace
+	item, err := defaultCache.Get(ctx, key)
+	require.NoError(t, err)
+	require.Nil(t, item)
+
+	item, err = c.Get(ctx, key)
+	require.NoError(t, err)
+	require.Equal(t, response, item)
+}
@@ -0,0 +1,80 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+
+	"github.com/Kyagara/equinox/v2/api"
+)
+
+// Cache usage counters for a single MethodID.
+type Stats struct {
+	Hits   uint64
+	Misses uint64
+	Sets   uint64
+	Errors uint64
+	// Counter of the bytes written by sets, it never decreases on deletion, expiry or overwrite. Not the size of the items stored.
+	BytesSet uint64
+}
+
+type methodStats struct {
+	hits     atomic.Uint64
+	misses   atomic.Uint64
+	sets     atomic.Uint64
+	errors   atomic.Uint64
+	bytesSet atomic.Uint64
+}
+
+// Returns a snapshot of the cache counters keyed by MethodID, e.g. "match-v5.getMatch".
+func (c *Cache) Stats() map[string]Stats {
+	snapshot := make(map[string]Stats, 1)
+	c.stats.Range(func(key, value any) bool {
+		stats := value.(*methodStats)
+		snapshot[key.(string)] = Stats{
+			Hits:     stats.hits.Load(),
+			Misses:   stats.misses.Load(),
+			Sets:     stats.sets.Load(),
+			Errors:   stats.errors.Load(),
+			BytesSet: stats.bytesSet.Load(),
+		}
+		return true
+	})
+	return snapshot
+}
+
+// Same as Get, also counts a hit, miss or error for the MethodID provided. A remembered 404 response counts as a hit.
+func (c *Cache) GetWithStats(ctx context.Context, methodID string, key string) ([]byte, error) {
+	item, err := c.Get(ctx, key)
+	stats := c.methodStats(methodID)
+	switch {
+	case item != nil, errors.Is(err, api.ErrNotFound):
+		stats.hits.Add(1)
+	case err != nil:
+		stats.errors.Add(1)
+	default:
+		stats.misses.Add(1)
+	}
+	return item, err
+}
+
+// Same as Set, also counts a set and its size, or an error, for the MethodID provided.
+func (c *Cache) SetWithStats(ctx context.Context, methodID string, key string, item []byte) error {
+	err := c.Set(ctx, key, item)
+	stats := c.methodStats(methodID)
+	if err != nil {
+		stats.errors.Add(1)
+		return err
+	}
+	stats.sets.Add(1)
+	stats.bytesSet.Add(uint64(len(item)))
+	return nil
+}
+
+func (c *Cache) methodStats(methodID string) *methodStats {
+	if stats, ok := c.stats.Load(methodID); ok {
+		return stats.(*methodStats)
+	}
+	stats, _ := c.stats.LoadOrStore(methodID, &methodStats{})
+	return stats.(*methodStats)
+}
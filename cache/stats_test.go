@@ -0,0 +1,50 @@
+package cache_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Kyagara/equinox/v2/cache"
+	"github.com/allegro/bigcache/v3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCacheStats(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	c, err := cache.NewBigCache(ctx, bigcache.DefaultConfig(4*time.Minute))
+	require.NoError(t, err)
+	require.Empty(t, c.Stats())
+
+	key := "https://euw1.api.riotgames.com"
+	response := []byte("{data: 123}")
+
+	item, err := c.GetWithStats(ctx, "match-v5.getMatch", key)
+	require.NoError(t, err)
+	require.Nil(t, item)
+
+	err = c.SetWithStats(ctx, "match-v5.getMatch", key, response)
+	require.NoError(t, err)
+
+	item, err = c.GetWithStats(ctx, "match-v5.getMatch", key)
+	require.NoError(t, err)
+	require.Equal(t, response, item)
+
+	_, err = c.GetWithStats(ctx, "summoner-v4.getByPUUID", key)
+	require.NoError(t, err)
+
+	stats := c.Stats()
+	require.Len(t, stats, 2)
+	require.Equal(t, cache.Stats{Hits: 1, Misses: 1, Sets: 1, BytesSet: uint64(len(response))}, stats["match-v5.getMatch"])
+	require.Equal(t, cache.Stats{Hits: 1}, stats["summoner-v4.getByPUUID"])
+
+	// Cache is disabled
+	disabled := &cache.Cache{}
+	_, err = disabled.GetWithStats(ctx, "match-v5.getMatch", key)
+	require.Equal(t, cache.ErrCacheIsDisabled, err)
+	err = disabled.SetWithStats(ctx, "match-v5.getMatch", key, response)
+	require.Equal(t, cache.ErrCacheIsDisabled, err)
+	require.Equal(t, cache.Stats{Errors: 2}, disabled.Stats()["match-v5.getMatch"])
+}
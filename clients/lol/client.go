@@ -62,3 +62,72 @@ func NewLOLClient(client *internal.Client) *Client {
 		TournamentV5:      TournamentV5{internal: client},
 	}
 }
+
+// Endpoint groups of the LOL Client, implemented by *Client and equinoxtest.LOL.
+type ClientAPI interface {
+	ChampionMasteryV4API() ChampionMasteryV4API
+	ChampionV3API() ChampionV3API
+	ClashV1API() ClashV1API
+	LeagueExpV4API() LeagueExpV4API
+	LeagueV4API() LeagueV4API
+	ChallengesV1API() ChallengesV1API
+	RsoMatchV1API() RsoMatchV1API
+	StatusV4API() StatusV4API
+	MatchV5API() MatchV5API
+	SpectatorV5API() SpectatorV5API
+	SummonerV4API() SummonerV4API
+	TournamentStubV5API() TournamentStubV5API
+	TournamentV5API() TournamentV5API
+}
+
+func (c *Client) ChampionMasteryV4API() ChampionMasteryV4API {
+	return &c.ChampionMasteryV4
+}
+
+func (c *Client) ChampionV3API() ChampionV3API {
+	return &c.ChampionV3
+}
+
+func (c *Client) ClashV1API() ClashV1API {
+	return &c.ClashV1
+}
+
+func (c *Client) LeagueExpV4API() LeagueExpV4API {
+	return &c.LeagueExpV4
+}
+
+func (c *Client) LeagueV4API() LeagueV4API {
+	return &c.LeagueV4
+}
+
+func (c *Client) ChallengesV1API() ChallengesV1API {
+	return &c.ChallengesV1
+}
+
+func (c *Client) RsoMatchV1API() RsoMatchV1API {
+	return &c.RsoMatchV1
+}
+
+func (c *Client) StatusV4API() StatusV4API {
+	return &c.StatusV4
+}
+
+func (c *Client) MatchV5API() MatchV5API {
+	return &c.MatchV5
+}
+
+func (c *Client) SpectatorV5API() SpectatorV5API {
+	return &c.SpectatorV5
+}
+
+func (c *Client) SummonerV4API() SummonerV4API {
+	return &c.SummonerV4
+}
+
+func (c *Client) TournamentStubV5API() TournamentStubV5API {
+	return &c.TournamentStubV5
+}
+
+func (c *Client) TournamentV5API() TournamentV5API {
+	return &c.TournamentV5
+}
@@ -1,6 +1,12 @@
 package lol
 
-import "strconv"
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/Kyagara/equinox/v2/api"
+)
 
 ///////////////////////////////////////////////
 //                                           //
@@ -101,6 +107,103 @@ func (route PlatformRoute) String() string {
 	}
 }
 
+// Returns the regional route of this platform route, or an empty string if the platform has none.
+func (route PlatformRoute) ToRegional() api.RegionalRoute {
+	switch route {
+	case BR1:
+		return api.AMERICAS
+	case EUN1:
+		return api.EUROPE
+	case EUW1:
+		return api.EUROPE
+	case JP1:
+		return api.ASIA
+	case KR:
+		return api.ASIA
+	case LA1:
+		return api.AMERICAS
+	case LA2:
+		return api.AMERICAS
+	case ME1:
+		return api.EUROPE
+	case NA1:
+		return api.AMERICAS
+	case OC1:
+		return api.SEA
+	case PBE1:
+		return api.AMERICAS
+	case PH2:
+		return api.SEA
+	case RU:
+		return api.EUROPE
+	case SG2:
+		return api.SEA
+	case TH2:
+		return api.SEA
+	case TR1:
+		return api.EUROPE
+	case TW2:
+		return api.SEA
+	case VN2:
+		return api.SEA
+	default:
+		return ""
+	}
+}
+
+// Returns the PlatformRoute matching the string, case-insensitive.
+func ParsePlatformRoute(route string) (PlatformRoute, error) {
+	switch strings.ToLower(route) {
+	case "br1":
+		return BR1, nil
+	case "eun1":
+		return EUN1, nil
+	case "euw1":
+		return EUW1, nil
+	case "jp1":
+		return JP1, nil
+	case "kr":
+		return KR, nil
+	case "la1":
+		return LA1, nil
+	case "la2":
+		return LA2, nil
+	case "me1":
+		return ME1, nil
+	case "na1":
+		return NA1, nil
+	case "oc1":
+		return OC1, nil
+	case "pbe1":
+		return PBE1, nil
+	case "ph2":
+		return PH2, nil
+	case "ru":
+		return RU, nil
+	case "sg2":
+		return SG2, nil
+	case "th2":
+		return TH2, nil
+	case "tr1":
+		return TR1, nil
+	case "tw2":
+		return TW2, nil
+	case "vn2":
+		return VN2, nil
+	default:
+		return "", fmt.Errorf("%w: unknown platform route '%s'", api.ErrInvalidRoute, route)
+	}
+}
+
+// Returns the PlatformRoute from the prefix of a match ID, such as 'EUW1' in 'EUW1_1234'.
+func PlatformRouteFromMatchID(matchID string) (PlatformRoute, error) {
+	prefix, _, ok := strings.Cut(matchID, "_")
+	if !ok {
+		return "", fmt.Errorf("%w: match ID '%s' has no platform prefix", api.ErrInvalidRoute, matchID)
+	}
+	return ParsePlatformRoute(prefix)
+}
+
 // Tournament regions for League of Legends.
 type TournamentRegion string
 
@@ -257,6 +360,111 @@ func (team Team) String() string {
 	}
 }
 
+// Types of the events in a match-v5 timeline.
+type EventType string
+
+const (
+	// Ascension game mode event.
+	ASCENDED_EVENT_EVENTTYPE EventType = "ASCENDED_EVENT"
+	// A turret or inhibitor was destroyed.
+	BUILDING_KILL_EVENTTYPE EventType = "BUILDING_KILL"
+	// Dominion capture point event.
+	CAPTURE_POINT_EVENTTYPE EventType = "CAPTURE_POINT"
+	// A champion was killed.
+	CHAMPION_KILL_EVENTTYPE EventType = "CHAMPION_KILL"
+	// First blood, multi kill or ace.
+	CHAMPION_SPECIAL_KILL_EVENTTYPE EventType = "CHAMPION_SPECIAL_KILL"
+	// Kayn transformed.
+	CHAMPION_TRANSFORM_EVENTTYPE EventType = "CHAMPION_TRANSFORM"
+	// A team received a dragon soul.
+	DRAGON_SOUL_GIVEN_EVENTTYPE EventType = "DRAGON_SOUL_GIVEN"
+	// A dragon, Baron Nashor, Rift Herald, Void Grub or Atakhan was killed.
+	ELITE_MONSTER_KILL_EVENTTYPE EventType = "ELITE_MONSTER_KILL"
+	// Progress in a Feat of Warfare.
+	FEAT_UPDATE_EVENTTYPE EventType = "FEAT_UPDATE"
+	// The game ended.
+	GAME_END_EVENTTYPE EventType = "GAME_END"
+	// An item was consumed or destroyed.
+	ITEM_DESTROYED_EVENTTYPE EventType = "ITEM_DESTROYED"
+	// An item was purchased.
+	ITEM_PURCHASED_EVENTTYPE EventType = "ITEM_PURCHASED"
+	// An item was sold.
+	ITEM_SOLD_EVENTTYPE EventType = "ITEM_SOLD"
+	// An item purchase or sale was undone.
+	ITEM_UNDO_EVENTTYPE EventType = "ITEM_UNDO"
+	// A champion leveled up.
+	LEVEL_UP_EVENTTYPE EventType = "LEVEL_UP"
+	// An objective bounty ended.
+	OBJECTIVE_BOUNTY_FINISH_EVENTTYPE EventType = "OBJECTIVE_BOUNTY_FINISH"
+	// An objective bounty is about to start.
+	OBJECTIVE_BOUNTY_PRESTART_EVENTTYPE EventType = "OBJECTIVE_BOUNTY_PRESTART"
+	// The game was unpaused, the first event of a timeline.
+	PAUSE_END_EVENTTYPE EventType = "PAUSE_END"
+	// The game was paused.
+	PAUSE_START_EVENTTYPE EventType = "PAUSE_START"
+	// A champion leveled up an ability.
+	SKILL_LEVEL_UP_EVENTTYPE EventType = "SKILL_LEVEL_UP"
+	// A turret plate was destroyed.
+	TURRET_PLATE_DESTROYED_EVENTTYPE EventType = "TURRET_PLATE_DESTROYED"
+	// A ward was destroyed.
+	WARD_KILL_EVENTTYPE EventType = "WARD_KILL"
+	// A ward was placed.
+	WARD_PLACED_EVENTTYPE EventType = "WARD_PLACED"
+)
+
+func (eventType EventType) String() string {
+	switch eventType {
+	case ASCENDED_EVENT_EVENTTYPE:
+		return "ASCENDED_EVENT"
+	case BUILDING_KILL_EVENTTYPE:
+		return "BUILDING_KILL"
+	case CAPTURE_POINT_EVENTTYPE:
+		return "CAPTURE_POINT"
+	case CHAMPION_KILL_EVENTTYPE:
+		return "CHAMPION_KILL"
+	case CHAMPION_SPECIAL_KILL_EVENTTYPE:
+		return "CHAMPION_SPECIAL_KILL"
+	case CHAMPION_TRANSFORM_EVENTTYPE:
+		return "CHAMPION_TRANSFORM"
+	case DRAGON_SOUL_GIVEN_EVENTTYPE:
+		return "DRAGON_SOUL_GIVEN"
+	case ELITE_MONSTER_KILL_EVENTTYPE:
+		return "ELITE_MONSTER_KILL"
+	case FEAT_UPDATE_EVENTTYPE:
+		return "FEAT_UPDATE"
+	case GAME_END_EVENTTYPE:
+		return "GAME_END"
+	case ITEM_DESTROYED_EVENTTYPE:
+		return "ITEM_DESTROYED"
+	case ITEM_PURCHASED_EVENTTYPE:
+		return "ITEM_PURCHASED"
+	case ITEM_SOLD_EVENTTYPE:
+		return "ITEM_SOLD"
+	case ITEM_UNDO_EVENTTYPE:
+		return "ITEM_UNDO"
+	case LEVEL_UP_EVENTTYPE:
+		return "LEVEL_UP"
+	case OBJECTIVE_BOUNTY_FINISH_EVENTTYPE:
+		return "OBJECTIVE_BOUNTY_FINISH"
+	case OBJECTIVE_BOUNTY_PRESTART_EVENTTYPE:
+		return "OBJECTIVE_BOUNTY_PRESTART"
+	case PAUSE_END_EVENTTYPE:
+		return "PAUSE_END"
+	case PAUSE_START_EVENTTYPE:
+		return "PAUSE_START"
+	case SKILL_LEVEL_UP_EVENTTYPE:
+		return "SKILL_LEVEL_UP"
+	case TURRET_PLATE_DESTROYED_EVENTTYPE:
+		return "TURRET_PLATE_DESTROYED"
+	case WARD_KILL_EVENTTYPE:
+		return "WARD_KILL"
+	case WARD_PLACED_EVENTTYPE:
+		return "WARD_PLACED"
+	default:
+		return string(eventType)
+	}
+}
+
 // League of Legends game types: matched game, custom game, or tutorial game.
 type GameType string
 
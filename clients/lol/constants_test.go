@@ -0,0 +1,33 @@
+package lol_test
+
+import (
+	"testing"
+
+	"github.com/Kyagara/equinox/v2/api"
+	"github.com/Kyagara/equinox/v2/clients/lol"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoutes(t *testing.T) {
+	require.Equal(t, api.SEA, lol.OC1.ToRegional())
+	require.Empty(t, lol.PlatformRoute("unknown").ToRegional())
+
+	platform, err := lol.ParsePlatformRoute("EUW1")
+	require.NoError(t, err)
+	require.Equal(t, lol.EUW1, platform)
+	_, err = lol.ParsePlatformRoute("euw")
+	require.ErrorIs(t, err, api.ErrInvalidRoute)
+
+	region, err := api.ParseRegionalRoute("Americas")
+	require.NoError(t, err)
+	require.Equal(t, api.AMERICAS, region)
+	_, err = api.ParseRegionalRoute("")
+	require.ErrorIs(t, err, api.ErrInvalidRoute)
+
+	platform, err = lol.PlatformRouteFromMatchID("OC1_1234")
+	require.NoError(t, err)
+	require.Equal(t, lol.OC1, platform)
+	require.Equal(t, api.SEA, platform.ToRegional())
+	_, err = lol.PlatformRouteFromMatchID("1234")
+	require.ErrorIs(t, err, api.ErrInvalidRoute)
+}
@@ -0,0 +1,109 @@
+package lol_test
+
+///////////////////////////////////////////////
+//                                           //
+//                     !                     //
+//   This file is automatically generated!   //
+//           Do not directly edit!           //
+//                                           //
+///////////////////////////////////////////////
+
+// Spec version = c5f59a3e27f5101b78b8c7eb9b3fb88318b4225d
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"testing"
+
+	jsonv2 "github.com/go-json-experiment/json"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Kyagara/equinox/v2/clients/lol"
+	"github.com/Kyagara/equinox/v2/test/util"
+)
+
+// Decodes the sample response of every method, 'test/data/<method ID>.json', rejecting unknown members and
+// failing on fields missing from the sample, see util.MissingMembers.
+//
+// Methods without a sample response are skipped, at least one sample is required.
+func TestDecoding(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		MethodID string
+		Target   any
+	}{
+		{"lol-challenges-v1.getAllChallengeConfigs", new([]lol.ChallengesChallengeConfigInfoV1DTO)},
+		{"lol-challenges-v1.getAllChallengePercentiles", new(map[int]map[lol.Tier]float64)},
+		{"lol-challenges-v1.getPlayerData", new(lol.ChallengesPlayerInfoV1DTO)},
+		{"lol-challenges-v1.getChallengeConfigs", new(lol.ChallengesChallengeConfigInfoV1DTO)},
+		{"lol-challenges-v1.getChallengeLeaderboards", new([]lol.ChallengesApexPlayerInfoV1DTO)},
+		{"lol-challenges-v1.getChallengePercentiles", new(map[lol.Tier]float64)},
+		{"champion-mastery-v4.getAllChampionMasteriesByPUUID", new([]lol.ChampionMasteryV4DTO)},
+		{"champion-mastery-v4.getChampionMasteryByPUUID", new(lol.ChampionMasteryV4DTO)},
+		{"champion-mastery-v4.getChampionMasteryScoreByPUUID", new(int)},
+		{"champion-mastery-v4.getTopChampionMasteriesByPUUID", new([]lol.ChampionMasteryV4DTO)},
+		{"champion-v3.getChampionInfo", new(lol.ChampionRotationV3DTO)},
+		{"clash-v1.getTournamentById", new(lol.ClashTournamentV1DTO)},
+		{"clash-v1.getTournamentByTeam", new(lol.ClashTournamentV1DTO)},
+		{"clash-v1.getPlayersByPUUID", new([]lol.ClashPlayerV1DTO)},
+		{"clash-v1.getTeamById", new(lol.ClashTeamV1DTO)},
+		{"clash-v1.getTournaments", new([]lol.ClashTournamentV1DTO)},
+		{"league-exp-v4.getLeagueEntries", new([]lol.LeagueExpLeagueEntryV4DTO)},
+		{"league-v4.getLeagueById", new(lol.LeagueListV4DTO)},
+		{"league-v4.getChallengerLeague", new(lol.LeagueListV4DTO)},
+		{"league-v4.getLeagueEntries", new([]lol.LeagueEntryV4DTO)},
+		{"league-v4.getLeagueEntriesByPUUID", new([]lol.LeagueEntryV4DTO)},
+		{"league-v4.getGrandmasterLeague", new(lol.LeagueListV4DTO)},
+		{"league-v4.getMasterLeague", new(lol.LeagueListV4DTO)},
+		{"match-v5.getMatch", new(lol.MatchV5DTO)},
+		{"match-v5.getMatchIdsByPUUID", new([]string)},
+		{"match-v5.getTimeline", new(lol.MatchTimelineV5DTO)},
+		{"lol-rso-match-v1.getMatch", new(lol.MatchV5DTO)},
+		{"lol-rso-match-v1.getMatchIds", new([]string)},
+		{"lol-rso-match-v1.getTimeline", new(lol.MatchTimelineV5DTO)},
+		{"spectator-v5.getCurrentGameInfoByPuuid", new(lol.SpectatorCurrentGameInfoV5DTO)},
+		{"spectator-v5.getFeaturedGames", new(lol.SpectatorFeaturedGamesV5DTO)},
+		{"lol-status-v4.getPlatformData", new(lol.StatusPlatformDataV4DTO)},
+		{"summoner-v4.getByAccessToken", new(lol.SummonerV4DTO)},
+		{"summoner-v4.getByPUUID", new(lol.SummonerV4DTO)},
+		{"summoner-v4.getByRSOPUUID", new(lol.SummonerV4DTO)},
+		{"tournament-stub-v5.createTournamentCode", new([]string)},
+		{"tournament-stub-v5.getLobbyEventsByCode", new(lol.TournamentStubLobbyEventWrapperV5DTO)},
+		{"tournament-stub-v5.registerProviderData", new(int)},
+		{"tournament-stub-v5.registerTournament", new(int)},
+		{"tournament-stub-v5.getTournamentCode", new(lol.TournamentStubCodeV5DTO)},
+		{"tournament-v5.createTournamentCode", new([]string)},
+		{"tournament-v5.getGames", new([]lol.TournamentGamesV5DTO)},
+		{"tournament-v5.getLobbyEventsByCode", new(lol.TournamentLobbyEventWrapperV5DTO)},
+		{"tournament-v5.registerProviderData", new(int)},
+		{"tournament-v5.registerTournament", new(int)},
+		{"tournament-v5.getTournamentCode", new(lol.TournamentCodeV5DTO)},
+	}
+
+	samples := 0
+	for _, test := range tests {
+		data, err := os.ReadFile("../../test/data/" + test.MethodID + ".json")
+		if err == nil {
+			samples++
+		}
+
+		t.Run(test.MethodID, func(t *testing.T) {
+			t.Parallel()
+
+			if errors.Is(err, fs.ErrNotExist) {
+				t.Skip("no sample response")
+			}
+			require.NoError(t, err)
+
+			require.NoError(t, jsonv2.Unmarshal(data, test.Target, jsonv2.RejectUnknownMembers(true)))
+
+			missing, err := util.MissingMembers(data, test.Target)
+			require.NoError(t, err)
+			require.Empty(t, missing, "fields missing from the sample response")
+		})
+	}
+
+	require.NotZero(t, samples, "no sample responses in 'test/data' for the lol client")
+}
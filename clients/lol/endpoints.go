@@ -12,6 +12,7 @@ package lol
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -132,6 +133,16 @@ func (endpoint *ChallengesV1) Configs(ctx context.Context, route PlatformRoute,
 	return &data, nil
 }
 
+// Optional parameters for ChallengesV1.LeaderboardsWithOptions, nil fields are omitted.
+type ChallengesV1LeaderboardsOptions struct {
+	Limit *int
+}
+
+// Returns an error wrapping api.ErrInvalidParameter if a parameter is outside of its documented range.
+func (opts *ChallengesV1LeaderboardsOptions) Validate() error {
+	return nil
+}
+
 // Return top players for each level. Level must be MASTER, GRANDMASTER or CHALLENGER.
 //
 // # Parameters
@@ -144,8 +155,39 @@ func (endpoint *ChallengesV1) Configs(ctx context.Context, route PlatformRoute,
 //
 // [lol-challenges-v1.getChallengeLeaderboards]
 //
+// Deprecated: Use LeaderboardsWithOptions instead, -1 or an empty string omits an optional parameter.
+//
 // [lol-challenges-v1.getChallengeLeaderboards]: https://developer.riotgames.com/api-methods/#lol-challenges-v1/GET_getChallengeLeaderboards
 func (endpoint *ChallengesV1) Leaderboards(ctx context.Context, route PlatformRoute, challengeId int, level Tier, limit int) ([]ChallengesApexPlayerInfoV1DTO, error) {
+	opts := &ChallengesV1LeaderboardsOptions{}
+	if limit != -1 {
+		opts.Limit = &limit
+	}
+	return endpoint.leaderboards(ctx, route, challengeId, level, opts)
+}
+
+// Return top players for each level. Level must be MASTER, GRANDMASTER or CHALLENGER.
+//
+// # Parameters
+//   - route: Route to query.
+//   - level
+//   - challengeId
+//   - opts (optional): Optional parameters, nil omits all of them.
+//
+// # Riot API Reference
+//
+// [lol-challenges-v1.getChallengeLeaderboards]
+//
+// [lol-challenges-v1.getChallengeLeaderboards]: https://developer.riotgames.com/api-methods/#lol-challenges-v1/GET_getChallengeLeaderboards
+func (endpoint *ChallengesV1) LeaderboardsWithOptions(ctx context.Context, route PlatformRoute, challengeId int, level Tier, opts *ChallengesV1LeaderboardsOptions) ([]ChallengesApexPlayerInfoV1DTO, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	return endpoint.leaderboards(ctx, route, challengeId, level, opts)
+}
+
+// Sends ChallengesV1.LeaderboardsWithOptions without validating opts.
+func (endpoint *ChallengesV1) leaderboards(ctx context.Context, route PlatformRoute, challengeId int, level Tier, opts *ChallengesV1LeaderboardsOptions) ([]ChallengesApexPlayerInfoV1DTO, error) {
 	logger := endpoint.internal.Logger("LOL_ChallengesV1_Leaderboards")
 	urlComponents := []string{"https://", route.String(), api.RIOT_API_BASE_URL_FORMAT, "/lol/challenges/v1/challenges/", strconv.FormatInt(int64(challengeId), 10), "/leaderboards/by-level/", level.String()}
 	request, err := endpoint.internal.Request(ctx, logger, http.MethodGet, urlComponents, "lol-challenges-v1.getChallengeLeaderboards", nil)
@@ -153,8 +195,10 @@ func (endpoint *ChallengesV1) Leaderboards(ctx context.Context, route PlatformRo
 		return nil, err
 	}
 	values := url.Values{}
-	if limit != -1 {
-		values.Set("limit", strconv.FormatInt(int64(limit), 10))
+	if opts != nil {
+		if opts.Limit != nil {
+			values.Set("limit", strconv.FormatInt(int64(*opts.Limit), 10))
+		}
 	}
 	request.Request.URL.RawQuery = values.Encode()
 	var data []ChallengesApexPlayerInfoV1DTO
@@ -279,6 +323,17 @@ func (endpoint *ChampionMasteryV4) MasteryScoreByPUUID(ctx context.Context, rout
 	return data, nil
 }
 
+// Optional parameters for ChampionMasteryV4.TopMasteriesByPUUIDWithOptions, nil fields are omitted.
+type ChampionMasteryV4TopMasteriesByPUUIDOptions struct {
+	// Number of entries to retrieve, defaults to 3.
+	Count *int
+}
+
+// Returns an error wrapping api.ErrInvalidParameter if a parameter is outside of its documented range.
+func (opts *ChampionMasteryV4TopMasteriesByPUUIDOptions) Validate() error {
+	return nil
+}
+
 // Get specified number of top champion mastery entries sorted by number of champion points descending.
 //
 // # Parameters
@@ -290,8 +345,38 @@ func (endpoint *ChampionMasteryV4) MasteryScoreByPUUID(ctx context.Context, rout
 //
 // [champion-mastery-v4.getTopChampionMasteriesByPUUID]
 //
+// Deprecated: Use TopMasteriesByPUUIDWithOptions instead, -1 or an empty string omits an optional parameter.
+//
 // [champion-mastery-v4.getTopChampionMasteriesByPUUID]: https://developer.riotgames.com/api-methods/#champion-mastery-v4/GET_getTopChampionMasteriesByPUUID
 func (endpoint *ChampionMasteryV4) TopMasteriesByPUUID(ctx context.Context, route PlatformRoute, encryptedPUUID string, count int) ([]ChampionMasteryV4DTO, error) {
+	opts := &ChampionMasteryV4TopMasteriesByPUUIDOptions{}
+	if count != -1 {
+		opts.Count = &count
+	}
+	return endpoint.topMasteriesByPUUID(ctx, route, encryptedPUUID, opts)
+}
+
+// Get specified number of top champion mastery entries sorted by number of champion points descending.
+//
+// # Parameters
+//   - route: Route to query.
+//   - encryptedPUUID
+//   - opts (optional): Optional parameters, nil omits all of them.
+//
+// # Riot API Reference
+//
+// [champion-mastery-v4.getTopChampionMasteriesByPUUID]
+//
+// [champion-mastery-v4.getTopChampionMasteriesByPUUID]: https://developer.riotgames.com/api-methods/#champion-mastery-v4/GET_getTopChampionMasteriesByPUUID
+func (endpoint *ChampionMasteryV4) TopMasteriesByPUUIDWithOptions(ctx context.Context, route PlatformRoute, encryptedPUUID string, opts *ChampionMasteryV4TopMasteriesByPUUIDOptions) ([]ChampionMasteryV4DTO, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	return endpoint.topMasteriesByPUUID(ctx, route, encryptedPUUID, opts)
+}
+
+// Sends ChampionMasteryV4.TopMasteriesByPUUIDWithOptions without validating opts.
+func (endpoint *ChampionMasteryV4) topMasteriesByPUUID(ctx context.Context, route PlatformRoute, encryptedPUUID string, opts *ChampionMasteryV4TopMasteriesByPUUIDOptions) ([]ChampionMasteryV4DTO, error) {
 	logger := endpoint.internal.Logger("LOL_ChampionMasteryV4_TopMasteriesByPUUID")
 	urlComponents := []string{"https://", route.String(), api.RIOT_API_BASE_URL_FORMAT, "/lol/champion-mastery/v4/champion-masteries/by-puuid/", encryptedPUUID, "/top"}
 	request, err := endpoint.internal.Request(ctx, logger, http.MethodGet, urlComponents, "champion-mastery-v4.getTopChampionMasteriesByPUUID", nil)
@@ -299,8 +384,10 @@ func (endpoint *ChampionMasteryV4) TopMasteriesByPUUID(ctx context.Context, rout
 		return nil, err
 	}
 	values := url.Values{}
-	if count != -1 {
-		values.Set("count", strconv.FormatInt(int64(count), 10))
+	if opts != nil {
+		if opts.Count != nil {
+			values.Set("count", strconv.FormatInt(int64(*opts.Count), 10))
+		}
 	}
 	request.Request.URL.RawQuery = values.Encode()
 	data := make([]ChampionMasteryV4DTO, 0, 3)
@@ -496,6 +583,17 @@ type LeagueExpV4 struct {
 	internal *internal.Client
 }
 
+// Optional parameters for LeagueExpV4.EntriesWithOptions, nil fields are omitted.
+type LeagueExpV4EntriesOptions struct {
+	// Defaults to 1. Starts with page 1.
+	Page *int
+}
+
+// Returns an error wrapping api.ErrInvalidParameter if a parameter is outside of its documented range.
+func (opts *LeagueExpV4EntriesOptions) Validate() error {
+	return nil
+}
+
 // Get all the league entries.
 //
 // # Parameters
@@ -509,8 +607,40 @@ type LeagueExpV4 struct {
 //
 // [league-exp-v4.getLeagueEntries]
 //
+// Deprecated: Use EntriesWithOptions instead, -1 or an empty string omits an optional parameter.
+//
 // [league-exp-v4.getLeagueEntries]: https://developer.riotgames.com/api-methods/#league-exp-v4/GET_getLeagueEntries
 func (endpoint *LeagueExpV4) Entries(ctx context.Context, route PlatformRoute, queue QueueType, tier Tier, division Division, page int) ([]LeagueExpLeagueEntryV4DTO, error) {
+	opts := &LeagueExpV4EntriesOptions{}
+	if page != -1 {
+		opts.Page = &page
+	}
+	return endpoint.entries(ctx, route, queue, tier, division, opts)
+}
+
+// Get all the league entries.
+//
+// # Parameters
+//   - route: Route to query.
+//   - queue: Note that the queue value must be a valid ranked queue.
+//   - tier
+//   - division
+//   - opts (optional): Optional parameters, nil omits all of them.
+//
+// # Riot API Reference
+//
+// [league-exp-v4.getLeagueEntries]
+//
+// [league-exp-v4.getLeagueEntries]: https://developer.riotgames.com/api-methods/#league-exp-v4/GET_getLeagueEntries
+func (endpoint *LeagueExpV4) EntriesWithOptions(ctx context.Context, route PlatformRoute, queue QueueType, tier Tier, division Division, opts *LeagueExpV4EntriesOptions) ([]LeagueExpLeagueEntryV4DTO, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	return endpoint.entries(ctx, route, queue, tier, division, opts)
+}
+
+// Sends LeagueExpV4.EntriesWithOptions without validating opts.
+func (endpoint *LeagueExpV4) entries(ctx context.Context, route PlatformRoute, queue QueueType, tier Tier, division Division, opts *LeagueExpV4EntriesOptions) ([]LeagueExpLeagueEntryV4DTO, error) {
 	logger := endpoint.internal.Logger("LOL_LeagueExpV4_Entries")
 	urlComponents := []string{"https://", route.String(), api.RIOT_API_BASE_URL_FORMAT, "/lol/league-exp/v4/entries/", queue.String(), "/", tier.String(), "/", division.String()}
 	request, err := endpoint.internal.Request(ctx, logger, http.MethodGet, urlComponents, "league-exp-v4.getLeagueEntries", nil)
@@ -518,8 +648,10 @@ func (endpoint *LeagueExpV4) Entries(ctx context.Context, route PlatformRoute, q
 		return nil, err
 	}
 	values := url.Values{}
-	if page != -1 {
-		values.Set("page", strconv.FormatInt(int64(page), 10))
+	if opts != nil {
+		if opts.Page != nil {
+			values.Set("page", strconv.FormatInt(int64(*opts.Page), 10))
+		}
 	}
 	request.Request.URL.RawQuery = values.Encode()
 	var data []LeagueExpLeagueEntryV4DTO
@@ -591,6 +723,17 @@ func (endpoint *LeagueV4) ChallengerByQueue(ctx context.Context, route PlatformR
 	return &data, nil
 }
 
+// Optional parameters for LeagueV4.EntriesWithOptions, nil fields are omitted.
+type LeagueV4EntriesOptions struct {
+	// Defaults to 1. Starts with page 1.
+	Page *int
+}
+
+// Returns an error wrapping api.ErrInvalidParameter if a parameter is outside of its documented range.
+func (opts *LeagueV4EntriesOptions) Validate() error {
+	return nil
+}
+
 // Get all the league entries.
 //
 // # Parameters
@@ -604,8 +747,40 @@ func (endpoint *LeagueV4) ChallengerByQueue(ctx context.Context, route PlatformR
 //
 // [league-v4.getLeagueEntries]
 //
+// Deprecated: Use EntriesWithOptions instead, -1 or an empty string omits an optional parameter.
+//
 // [league-v4.getLeagueEntries]: https://developer.riotgames.com/api-methods/#league-v4/GET_getLeagueEntries
 func (endpoint *LeagueV4) Entries(ctx context.Context, route PlatformRoute, queue QueueType, tier Tier, division Division, page int) ([]LeagueEntryV4DTO, error) {
+	opts := &LeagueV4EntriesOptions{}
+	if page != -1 {
+		opts.Page = &page
+	}
+	return endpoint.entries(ctx, route, queue, tier, division, opts)
+}
+
+// Get all the league entries.
+//
+// # Parameters
+//   - route: Route to query.
+//   - division
+//   - tier
+//   - queue: Note that the queue value must be a valid ranked queue.
+//   - opts (optional): Optional parameters, nil omits all of them.
+//
+// # Riot API Reference
+//
+// [league-v4.getLeagueEntries]
+//
+// [league-v4.getLeagueEntries]: https://developer.riotgames.com/api-methods/#league-v4/GET_getLeagueEntries
+func (endpoint *LeagueV4) EntriesWithOptions(ctx context.Context, route PlatformRoute, queue QueueType, tier Tier, division Division, opts *LeagueV4EntriesOptions) ([]LeagueEntryV4DTO, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	return endpoint.entries(ctx, route, queue, tier, division, opts)
+}
+
+// Sends LeagueV4.EntriesWithOptions without validating opts.
+func (endpoint *LeagueV4) entries(ctx context.Context, route PlatformRoute, queue QueueType, tier Tier, division Division, opts *LeagueV4EntriesOptions) ([]LeagueEntryV4DTO, error) {
 	logger := endpoint.internal.Logger("LOL_LeagueV4_Entries")
 	urlComponents := []string{"https://", route.String(), api.RIOT_API_BASE_URL_FORMAT, "/lol/league/v4/entries/", queue.String(), "/", tier.String(), "/", division.String()}
 	request, err := endpoint.internal.Request(ctx, logger, http.MethodGet, urlComponents, "league-v4.getLeagueEntries", nil)
@@ -613,8 +788,10 @@ func (endpoint *LeagueV4) Entries(ctx context.Context, route PlatformRoute, queu
 		return nil, err
 	}
 	values := url.Values{}
-	if page != -1 {
-		values.Set("page", strconv.FormatInt(int64(page), 10))
+	if opts != nil {
+		if opts.Page != nil {
+			values.Set("page", strconv.FormatInt(int64(*opts.Page), 10))
+		}
 	}
 	request.Request.URL.RawQuery = values.Encode()
 	var data []LeagueEntryV4DTO
@@ -738,6 +915,33 @@ func (endpoint *MatchV5) ByID(ctx context.Context, route api.RegionalRoute, matc
 	return &data, nil
 }
 
+// Optional parameters for MatchV5.ListByPUUIDWithOptions, nil fields are omitted.
+type MatchV5ListByPUUIDOptions struct {
+	// Epoch timestamp in seconds. The matchlist started storing timestamps on June 16th, 2021. Any matches played before June 16th, 2021 won't be included in the results if the startTime filter is set.
+	StartTime *int
+	// Epoch timestamp in seconds.
+	EndTime *int
+	// Filter the list of match ids by a specific queue id. This filter is mutually inclusive of the type filter meaning any match ids returned must match both the queue and type filters.
+	Queue *Queue
+	// Filter the list of match ids by the type of match. This filter is mutually inclusive of the queue filter meaning any match ids returned must match both the queue and type filters.
+	Type *string
+	// Defaults to 0. Start index.
+	Start *int
+	// Defaults to 20. Valid values: 0 to 100. Number of match ids to return.
+	Count *int
+}
+
+// Returns an error wrapping api.ErrInvalidParameter if a parameter is outside of its documented range.
+func (opts *MatchV5ListByPUUIDOptions) Validate() error {
+	if opts == nil {
+		return nil
+	}
+	if opts.Count != nil && (*opts.Count < 0 || *opts.Count > 100) {
+		return fmt.Errorf("%w: count must be between 0 and 100, got %d", api.ErrInvalidParameter, *opts.Count)
+	}
+	return nil
+}
+
 // Get a list of match ids by puuid
 //
 // # Parameters
@@ -754,32 +958,79 @@ func (endpoint *MatchV5) ByID(ctx context.Context, route api.RegionalRoute, matc
 //
 // [match-v5.getMatchIdsByPUUID]
 //
+// Deprecated: Use ListByPUUIDWithOptions instead, -1 or an empty string omits an optional parameter.
+//
 // [match-v5.getMatchIdsByPUUID]: https://developer.riotgames.com/api-methods/#match-v5/GET_getMatchIdsByPUUID
 func (endpoint *MatchV5) ListByPUUID(ctx context.Context, route api.RegionalRoute, puuid string, startTime int, endTime int, queue Queue, matchType string, start int, count int) ([]string, error) {
-	logger := endpoint.internal.Logger("LOL_MatchV5_ListByPUUID")
-	urlComponents := []string{"https://", route.String(), api.RIOT_API_BASE_URL_FORMAT, "/lol/match/v5/matches/by-puuid/", puuid, "/ids"}
-	request, err := endpoint.internal.Request(ctx, logger, http.MethodGet, urlComponents, "match-v5.getMatchIdsByPUUID", nil)
-	if err != nil {
-		return nil, err
-	}
-	values := url.Values{}
-	if count != -1 {
-		values.Set("count", strconv.FormatInt(int64(count), 10))
+	opts := &MatchV5ListByPUUIDOptions{}
+	if startTime != -1 {
+		opts.StartTime = &startTime
 	}
 	if endTime != -1 {
-		values.Set("endTime", strconv.FormatInt(int64(endTime), 10))
-	}
-	if matchType != "" {
-		values.Set("type", matchType)
+		opts.EndTime = &endTime
 	}
 	if queue != -1 {
-		values.Set("queue", queue.String())
+		opts.Queue = &queue
+	}
+	if matchType != "" {
+		opts.Type = &matchType
 	}
 	if start != -1 {
-		values.Set("start", strconv.FormatInt(int64(start), 10))
+		opts.Start = &start
 	}
-	if startTime != -1 {
-		values.Set("startTime", strconv.FormatInt(int64(startTime), 10))
+	if count != -1 {
+		opts.Count = &count
+	}
+	return endpoint.listByPUUID(ctx, route, puuid, opts)
+}
+
+// Get a list of match ids by puuid
+//
+// # Parameters
+//   - route: Route to query.
+//   - puuid
+//   - opts (optional): Optional parameters, nil omits all of them.
+//
+// # Riot API Reference
+//
+// [match-v5.getMatchIdsByPUUID]
+//
+// [match-v5.getMatchIdsByPUUID]: https://developer.riotgames.com/api-methods/#match-v5/GET_getMatchIdsByPUUID
+func (endpoint *MatchV5) ListByPUUIDWithOptions(ctx context.Context, route api.RegionalRoute, puuid string, opts *MatchV5ListByPUUIDOptions) ([]string, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	return endpoint.listByPUUID(ctx, route, puuid, opts)
+}
+
+// Sends MatchV5.ListByPUUIDWithOptions without validating opts.
+func (endpoint *MatchV5) listByPUUID(ctx context.Context, route api.RegionalRoute, puuid string, opts *MatchV5ListByPUUIDOptions) ([]string, error) {
+	logger := endpoint.internal.Logger("LOL_MatchV5_ListByPUUID")
+	urlComponents := []string{"https://", route.String(), api.RIOT_API_BASE_URL_FORMAT, "/lol/match/v5/matches/by-puuid/", puuid, "/ids"}
+	request, err := endpoint.internal.Request(ctx, logger, http.MethodGet, urlComponents, "match-v5.getMatchIdsByPUUID", nil)
+	if err != nil {
+		return nil, err
+	}
+	values := url.Values{}
+	if opts != nil {
+		if opts.Count != nil {
+			values.Set("count", strconv.FormatInt(int64(*opts.Count), 10))
+		}
+		if opts.EndTime != nil {
+			values.Set("endTime", strconv.FormatInt(int64(*opts.EndTime), 10))
+		}
+		if opts.Queue != nil {
+			values.Set("queue", opts.Queue.String())
+		}
+		if opts.Start != nil {
+			values.Set("start", strconv.FormatInt(int64(*opts.Start), 10))
+		}
+		if opts.StartTime != nil {
+			values.Set("startTime", strconv.FormatInt(int64(*opts.StartTime), 10))
+		}
+		if opts.Type != nil {
+			values.Set("type", *opts.Type)
+		}
 	}
 	request.Request.URL.RawQuery = values.Encode()
 	data := make([]string, 0, 20)
@@ -856,6 +1107,33 @@ func (endpoint *RsoMatchV1) ByID(ctx context.Context, route api.RegionalRoute, a
 	return &data, nil
 }
 
+// Optional parameters for RsoMatchV1.MatchIdsWithOptions, nil fields are omitted.
+type RsoMatchV1MatchIdsOptions struct {
+	// Defaults to 20. Valid values: 0 to 100. Number of match ids to return.
+	Count *int
+	// Defaults to 0. Start index.
+	Start *int
+	// Filter the list of match ids by the type of match. This filter is mutually inclusive of the queue filter meaning any match ids returned must match both the queue and type filters.
+	Type *string
+	// Filter the list of match ids by a specific queue id. This filter is mutually inclusive of the type filter meaning any match ids returned must match both the queue and type filters.
+	Queue *int
+	// Epoch timestamp in seconds.
+	EndTime *int
+	// Epoch timestamp in seconds. The matchlist started storing timestamps on June 16th, 2021. Any matches played before June 16th, 2021 won't be included in the results if the startTime filter is set.
+	StartTime *int
+}
+
+// Returns an error wrapping api.ErrInvalidParameter if a parameter is outside of its documented range.
+func (opts *RsoMatchV1MatchIdsOptions) Validate() error {
+	if opts == nil {
+		return nil
+	}
+	if opts.Count != nil && (*opts.Count < 0 || *opts.Count > 100) {
+		return fmt.Errorf("%w: count must be between 0 and 100, got %d", api.ErrInvalidParameter, *opts.Count)
+	}
+	return nil
+}
+
 // Get a list of match ids by player access token - Includes custom matches
 //
 // # Parameters
@@ -872,32 +1150,79 @@ func (endpoint *RsoMatchV1) ByID(ctx context.Context, route api.RegionalRoute, a
 //
 // [lol-rso-match-v1.getMatchIds]
 //
+// Deprecated: Use MatchIdsWithOptions instead, -1 or an empty string omits an optional parameter.
+//
 // [lol-rso-match-v1.getMatchIds]: https://developer.riotgames.com/api-methods/#lol-rso-match-v1/GET_getMatchIds
 func (endpoint *RsoMatchV1) MatchIds(ctx context.Context, route api.RegionalRoute, accessToken string, count int, start int, matchType string, queue int, endTime int, startTime int) ([]string, error) {
-	logger := endpoint.internal.Logger("LOL_RsoMatchV1_MatchIds")
-	urlComponents := []string{"https://", route.String(), api.RIOT_API_BASE_URL_FORMAT, "/lol/rso-match/v1/matches/ids"}
-	request, err := endpoint.internal.Request(ctx, logger, http.MethodGet, urlComponents, "lol-rso-match-v1.getMatchIds", nil)
-	if err != nil {
-		return nil, err
-	}
-	values := url.Values{}
+	opts := &RsoMatchV1MatchIdsOptions{}
 	if count != -1 {
-		values.Set("count", strconv.FormatInt(int64(count), 10))
+		opts.Count = &count
 	}
-	if endTime != -1 {
-		values.Set("endTime", strconv.FormatInt(int64(endTime), 10))
+	if start != -1 {
+		opts.Start = &start
 	}
 	if matchType != "" {
-		values.Set("type", matchType)
+		opts.Type = &matchType
 	}
 	if queue != -1 {
-		values.Set("queue", strconv.FormatInt(int64(queue), 10))
+		opts.Queue = &queue
 	}
-	if start != -1 {
-		values.Set("start", strconv.FormatInt(int64(start), 10))
+	if endTime != -1 {
+		opts.EndTime = &endTime
 	}
 	if startTime != -1 {
-		values.Set("startTime", strconv.FormatInt(int64(startTime), 10))
+		opts.StartTime = &startTime
+	}
+	return endpoint.matchIds(ctx, route, accessToken, opts)
+}
+
+// Get a list of match ids by player access token - Includes custom matches
+//
+// # Parameters
+//   - route: Route to query.
+//   - accessToken: RSO access token.
+//   - opts (optional): Optional parameters, nil omits all of them.
+//
+// # Riot API Reference
+//
+// [lol-rso-match-v1.getMatchIds]
+//
+// [lol-rso-match-v1.getMatchIds]: https://developer.riotgames.com/api-methods/#lol-rso-match-v1/GET_getMatchIds
+func (endpoint *RsoMatchV1) MatchIdsWithOptions(ctx context.Context, route api.RegionalRoute, accessToken string, opts *RsoMatchV1MatchIdsOptions) ([]string, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	return endpoint.matchIds(ctx, route, accessToken, opts)
+}
+
+// Sends RsoMatchV1.MatchIdsWithOptions without validating opts.
+func (endpoint *RsoMatchV1) matchIds(ctx context.Context, route api.RegionalRoute, accessToken string, opts *RsoMatchV1MatchIdsOptions) ([]string, error) {
+	logger := endpoint.internal.Logger("LOL_RsoMatchV1_MatchIds")
+	urlComponents := []string{"https://", route.String(), api.RIOT_API_BASE_URL_FORMAT, "/lol/rso-match/v1/matches/ids"}
+	request, err := endpoint.internal.Request(ctx, logger, http.MethodGet, urlComponents, "lol-rso-match-v1.getMatchIds", nil)
+	if err != nil {
+		return nil, err
+	}
+	values := url.Values{}
+	if opts != nil {
+		if opts.Count != nil {
+			values.Set("count", strconv.FormatInt(int64(*opts.Count), 10))
+		}
+		if opts.EndTime != nil {
+			values.Set("endTime", strconv.FormatInt(int64(*opts.EndTime), 10))
+		}
+		if opts.Queue != nil {
+			values.Set("queue", strconv.FormatInt(int64(*opts.Queue), 10))
+		}
+		if opts.Start != nil {
+			values.Set("start", strconv.FormatInt(int64(*opts.Start), 10))
+		}
+		if opts.StartTime != nil {
+			values.Set("startTime", strconv.FormatInt(int64(*opts.StartTime), 10))
+		}
+		if opts.Type != nil {
+			values.Set("type", *opts.Type)
+		}
 	}
 	request.Request.URL.RawQuery = values.Encode()
 	request.Request.Header = request.Request.Header.Clone()
@@ -1137,6 +1462,23 @@ type TournamentStubV5 struct {
 	internal *internal.Client
 }
 
+// Optional parameters for TournamentStubV5.CreateTournamentCodeWithOptions, nil fields are omitted.
+type TournamentStubV5CreateTournamentCodeOptions struct {
+	// The number of codes to create (max 1000)
+	Count *int
+}
+
+// Returns an error wrapping api.ErrInvalidParameter if a parameter is outside of its documented range.
+func (opts *TournamentStubV5CreateTournamentCodeOptions) Validate() error {
+	if opts == nil {
+		return nil
+	}
+	if opts.Count != nil && *opts.Count > 1000 {
+		return fmt.Errorf("%w: count must be at most 1000, got %d", api.ErrInvalidParameter, *opts.Count)
+	}
+	return nil
+}
+
 // Create a tournament code for the given tournament - Stub method
 //
 // # Parameters
@@ -1148,8 +1490,38 @@ type TournamentStubV5 struct {
 //
 // [tournament-stub-v5.createTournamentCode]
 //
+// Deprecated: Use CreateTournamentCodeWithOptions instead, -1 or an empty string omits an optional parameter.
+//
 // [tournament-stub-v5.createTournamentCode]: https://developer.riotgames.com/api-methods/#tournament-stub-v5/POST_createTournamentCode
 func (endpoint *TournamentStubV5) CreateTournamentCode(ctx context.Context, route api.RegionalRoute, body *TournamentStubCodeParametersV5DTO, count int, tournamentId int) ([]string, error) {
+	opts := &TournamentStubV5CreateTournamentCodeOptions{}
+	if count != -1 {
+		opts.Count = &count
+	}
+	return endpoint.createTournamentCode(ctx, route, body, tournamentId, opts)
+}
+
+// Create a tournament code for the given tournament - Stub method
+//
+// # Parameters
+//   - route: Route to query.
+//   - tournamentId: The tournament ID
+//   - opts (optional): Optional parameters, nil omits all of them.
+//
+// # Riot API Reference
+//
+// [tournament-stub-v5.createTournamentCode]
+//
+// [tournament-stub-v5.createTournamentCode]: https://developer.riotgames.com/api-methods/#tournament-stub-v5/POST_createTournamentCode
+func (endpoint *TournamentStubV5) CreateTournamentCodeWithOptions(ctx context.Context, route api.RegionalRoute, body *TournamentStubCodeParametersV5DTO, tournamentId int, opts *TournamentStubV5CreateTournamentCodeOptions) ([]string, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	return endpoint.createTournamentCode(ctx, route, body, tournamentId, opts)
+}
+
+// Sends TournamentStubV5.CreateTournamentCodeWithOptions without validating opts.
+func (endpoint *TournamentStubV5) createTournamentCode(ctx context.Context, route api.RegionalRoute, body *TournamentStubCodeParametersV5DTO, tournamentId int, opts *TournamentStubV5CreateTournamentCodeOptions) ([]string, error) {
 	logger := endpoint.internal.Logger("LOL_TournamentStubV5_CreateTournamentCode")
 	urlComponents := []string{"https://", route.String(), api.RIOT_API_BASE_URL_FORMAT, "/lol/tournament-stub/v5/codes"}
 	request, err := endpoint.internal.Request(ctx, logger, http.MethodPost, urlComponents, "tournament-stub-v5.createTournamentCode", body)
@@ -1157,12 +1529,14 @@ func (endpoint *TournamentStubV5) CreateTournamentCode(ctx context.Context, rout
 		return nil, err
 	}
 	values := url.Values{}
-	if count != -1 {
-		values.Set("count", strconv.FormatInt(int64(count), 10))
-	}
 	if tournamentId != -1 {
 		values.Set("tournamentId", strconv.FormatInt(int64(tournamentId), 10))
 	}
+	if opts != nil {
+		if opts.Count != nil {
+			values.Set("count", strconv.FormatInt(int64(*opts.Count), 10))
+		}
+	}
 	request.Request.URL.RawQuery = values.Encode()
 	var data []string
 	err = endpoint.internal.Execute(ctx, request, &data)
@@ -1287,6 +1661,23 @@ type TournamentV5 struct {
 	internal *internal.Client
 }
 
+// Optional parameters for TournamentV5.CreateTournamentCodeWithOptions, nil fields are omitted.
+type TournamentV5CreateTournamentCodeOptions struct {
+	// The number of codes to create (max 1000)
+	Count *int
+}
+
+// Returns an error wrapping api.ErrInvalidParameter if a parameter is outside of its documented range.
+func (opts *TournamentV5CreateTournamentCodeOptions) Validate() error {
+	if opts == nil {
+		return nil
+	}
+	if opts.Count != nil && *opts.Count > 1000 {
+		return fmt.Errorf("%w: count must be at most 1000, got %d", api.ErrInvalidParameter, *opts.Count)
+	}
+	return nil
+}
+
 // Create a tournament code for the given tournament.
 //
 // # Parameters
@@ -1298,8 +1689,38 @@ type TournamentV5 struct {
 //
 // [tournament-v5.createTournamentCode]
 //
+// Deprecated: Use CreateTournamentCodeWithOptions instead, -1 or an empty string omits an optional parameter.
+//
 // [tournament-v5.createTournamentCode]: https://developer.riotgames.com/api-methods/#tournament-v5/POST_createTournamentCode
 func (endpoint *TournamentV5) CreateTournamentCode(ctx context.Context, route api.RegionalRoute, body *TournamentCodeParametersV5DTO, tournamentId int, count int) ([]string, error) {
+	opts := &TournamentV5CreateTournamentCodeOptions{}
+	if count != -1 {
+		opts.Count = &count
+	}
+	return endpoint.createTournamentCode(ctx, route, body, tournamentId, opts)
+}
+
+// Create a tournament code for the given tournament.
+//
+// # Parameters
+//   - route: Route to query.
+//   - tournamentId: The tournament ID
+//   - opts (optional): Optional parameters, nil omits all of them.
+//
+// # Riot API Reference
+//
+// [tournament-v5.createTournamentCode]
+//
+// [tournament-v5.createTournamentCode]: https://developer.riotgames.com/api-methods/#tournament-v5/POST_createTournamentCode
+func (endpoint *TournamentV5) CreateTournamentCodeWithOptions(ctx context.Context, route api.RegionalRoute, body *TournamentCodeParametersV5DTO, tournamentId int, opts *TournamentV5CreateTournamentCodeOptions) ([]string, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	return endpoint.createTournamentCode(ctx, route, body, tournamentId, opts)
+}
+
+// Sends TournamentV5.CreateTournamentCodeWithOptions without validating opts.
+func (endpoint *TournamentV5) createTournamentCode(ctx context.Context, route api.RegionalRoute, body *TournamentCodeParametersV5DTO, tournamentId int, opts *TournamentV5CreateTournamentCodeOptions) ([]string, error) {
 	logger := endpoint.internal.Logger("LOL_TournamentV5_CreateTournamentCode")
 	urlComponents := []string{"https://", route.String(), api.RIOT_API_BASE_URL_FORMAT, "/lol/tournament/v5/codes"}
 	request, err := endpoint.internal.Request(ctx, logger, http.MethodPost, urlComponents, "tournament-v5.createTournamentCode", body)
@@ -1307,12 +1728,14 @@ func (endpoint *TournamentV5) CreateTournamentCode(ctx context.Context, route ap
 		return nil, err
 	}
 	values := url.Values{}
-	if count != -1 {
-		values.Set("count", strconv.FormatInt(int64(count), 10))
-	}
 	if tournamentId != -1 {
 		values.Set("tournamentId", strconv.FormatInt(int64(tournamentId), 10))
 	}
+	if opts != nil {
+		if opts.Count != nil {
+			values.Set("count", strconv.FormatInt(int64(*opts.Count), 10))
+		}
+	}
 	request.Request.URL.RawQuery = values.Encode()
 	var data []string
 	err = endpoint.internal.Execute(ctx, request, &data)
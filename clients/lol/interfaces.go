@@ -0,0 +1,132 @@
+package lol
+
+///////////////////////////////////////////////
+//                                           //
+//                     !                     //
+//   This file is automatically generated!   //
+//           Do not directly edit!           //
+//                                           //
+///////////////////////////////////////////////
+
+// Spec version = c5f59a3e27f5101b78b8c7eb9b3fb88318b4225d
+
+import (
+	"context"
+
+	"github.com/Kyagara/equinox/v2/api"
+)
+
+// Methods of ChallengesV1, implemented by *ChallengesV1 and equinoxtest.LOLChallengesV1.
+type ChallengesV1API interface {
+	AllConfigs(ctx context.Context, route PlatformRoute) ([]ChallengesChallengeConfigInfoV1DTO, error)
+	AllPercentiles(ctx context.Context, route PlatformRoute) (map[int]map[Tier]float64, error)
+	ByPUUID(ctx context.Context, route PlatformRoute, puuid string) (*ChallengesPlayerInfoV1DTO, error)
+	Configs(ctx context.Context, route PlatformRoute, challengeId int) (*ChallengesChallengeConfigInfoV1DTO, error)
+	// Deprecated: Use LeaderboardsWithOptions instead.
+	Leaderboards(ctx context.Context, route PlatformRoute, challengeId int, level Tier, limit int) ([]ChallengesApexPlayerInfoV1DTO, error)
+	LeaderboardsWithOptions(ctx context.Context, route PlatformRoute, challengeId int, level Tier, opts *ChallengesV1LeaderboardsOptions) ([]ChallengesApexPlayerInfoV1DTO, error)
+	Percentiles(ctx context.Context, route PlatformRoute, challengeId int) (map[Tier]float64, error)
+}
+
+// Methods of ChampionMasteryV4, implemented by *ChampionMasteryV4 and equinoxtest.LOLChampionMasteryV4.
+type ChampionMasteryV4API interface {
+	AllMasteriesByPUUID(ctx context.Context, route PlatformRoute, encryptedPUUID string) ([]ChampionMasteryV4DTO, error)
+	MasteryByPUUID(ctx context.Context, route PlatformRoute, encryptedPUUID string, championId int) (*ChampionMasteryV4DTO, error)
+	MasteryScoreByPUUID(ctx context.Context, route PlatformRoute, encryptedPUUID string) (int, error)
+	// Deprecated: Use TopMasteriesByPUUIDWithOptions instead.
+	TopMasteriesByPUUID(ctx context.Context, route PlatformRoute, encryptedPUUID string, count int) ([]ChampionMasteryV4DTO, error)
+	TopMasteriesByPUUIDWithOptions(ctx context.Context, route PlatformRoute, encryptedPUUID string, opts *ChampionMasteryV4TopMasteriesByPUUIDOptions) ([]ChampionMasteryV4DTO, error)
+}
+
+// Methods of ChampionV3, implemented by *ChampionV3 and equinoxtest.LOLChampionV3.
+type ChampionV3API interface {
+	Rotation(ctx context.Context, route PlatformRoute) (*ChampionRotationV3DTO, error)
+}
+
+// Methods of ClashV1, implemented by *ClashV1 and equinoxtest.LOLClashV1.
+type ClashV1API interface {
+	ByID(ctx context.Context, route PlatformRoute, tournamentId int) (*ClashTournamentV1DTO, error)
+	ByTeamID(ctx context.Context, route PlatformRoute, teamId string) (*ClashTournamentV1DTO, error)
+	SummonerEntriesByPUUID(ctx context.Context, route PlatformRoute, puuid string) ([]ClashPlayerV1DTO, error)
+	TeamByTeamID(ctx context.Context, route PlatformRoute, teamId string) (*ClashTeamV1DTO, error)
+	Tournaments(ctx context.Context, route PlatformRoute) ([]ClashTournamentV1DTO, error)
+}
+
+// Methods of LeagueExpV4, implemented by *LeagueExpV4 and equinoxtest.LOLLeagueExpV4.
+type LeagueExpV4API interface {
+	// Deprecated: Use EntriesWithOptions instead.
+	Entries(ctx context.Context, route PlatformRoute, queue QueueType, tier Tier, division Division, page int) ([]LeagueExpLeagueEntryV4DTO, error)
+	EntriesWithOptions(ctx context.Context, route PlatformRoute, queue QueueType, tier Tier, division Division, opts *LeagueExpV4EntriesOptions) ([]LeagueExpLeagueEntryV4DTO, error)
+}
+
+// Methods of LeagueV4, implemented by *LeagueV4 and equinoxtest.LOLLeagueV4.
+type LeagueV4API interface {
+	ByID(ctx context.Context, route PlatformRoute, leagueId string) (*LeagueListV4DTO, error)
+	ChallengerByQueue(ctx context.Context, route PlatformRoute, queue QueueType) (*LeagueListV4DTO, error)
+	// Deprecated: Use EntriesWithOptions instead.
+	Entries(ctx context.Context, route PlatformRoute, queue QueueType, tier Tier, division Division, page int) ([]LeagueEntryV4DTO, error)
+	EntriesWithOptions(ctx context.Context, route PlatformRoute, queue QueueType, tier Tier, division Division, opts *LeagueV4EntriesOptions) ([]LeagueEntryV4DTO, error)
+	EntriesByPUUID(ctx context.Context, route PlatformRoute, encryptedPUUID string) ([]LeagueEntryV4DTO, error)
+	GrandmasterByQueue(ctx context.Context, route PlatformRoute, queue QueueType) (*LeagueListV4DTO, error)
+	MasterByQueue(ctx context.Context, route PlatformRoute, queue QueueType) (*LeagueListV4DTO, error)
+}
+
+// Methods of MatchV5, implemented by *MatchV5 and equinoxtest.LOLMatchV5.
+type MatchV5API interface {
+	ByID(ctx context.Context, route api.RegionalRoute, matchId string) (*MatchV5DTO, error)
+	// Deprecated: Use ListByPUUIDWithOptions instead.
+	ListByPUUID(ctx context.Context, route api.RegionalRoute, puuid string, startTime int, endTime int, queue Queue, matchType string, start int, count int) ([]string, error)
+	ListByPUUIDWithOptions(ctx context.Context, route api.RegionalRoute, puuid string, opts *MatchV5ListByPUUIDOptions) ([]string, error)
+	Timeline(ctx context.Context, route api.RegionalRoute, matchId string) (*MatchTimelineV5DTO, error)
+}
+
+// Methods of RsoMatchV1, implemented by *RsoMatchV1 and equinoxtest.LOLRsoMatchV1.
+type RsoMatchV1API interface {
+	ByID(ctx context.Context, route api.RegionalRoute, accessToken string, matchId string) (*MatchV5DTO, error)
+	// Deprecated: Use MatchIdsWithOptions instead.
+	MatchIds(ctx context.Context, route api.RegionalRoute, accessToken string, count int, start int, matchType string, queue int, endTime int, startTime int) ([]string, error)
+	MatchIdsWithOptions(ctx context.Context, route api.RegionalRoute, accessToken string, opts *RsoMatchV1MatchIdsOptions) ([]string, error)
+	Timeline(ctx context.Context, route api.RegionalRoute, accessToken string, matchId string) (*MatchTimelineV5DTO, error)
+}
+
+// Methods of SpectatorV5, implemented by *SpectatorV5 and equinoxtest.LOLSpectatorV5.
+type SpectatorV5API interface {
+	CurrentGameInfoByPUUID(ctx context.Context, route PlatformRoute, encryptedPUUID string) (*SpectatorCurrentGameInfoV5DTO, error)
+	Featured(ctx context.Context, route PlatformRoute) (*SpectatorFeaturedGamesV5DTO, error)
+}
+
+// Methods of StatusV4, implemented by *StatusV4 and equinoxtest.LOLStatusV4.
+type StatusV4API interface {
+	Platform(ctx context.Context, route PlatformRoute) (*StatusPlatformDataV4DTO, error)
+}
+
+// Methods of SummonerV4, implemented by *SummonerV4 and equinoxtest.LOLSummonerV4.
+type SummonerV4API interface {
+	ByAccessToken(ctx context.Context, route PlatformRoute, accessToken string) (*SummonerV4DTO, error)
+	ByPUUID(ctx context.Context, route PlatformRoute, encryptedPUUID string) (*SummonerV4DTO, error)
+	ByRSOPUUID(ctx context.Context, route PlatformRoute, rsoPUUID string) (*SummonerV4DTO, error)
+}
+
+// Methods of TournamentStubV5, implemented by *TournamentStubV5 and equinoxtest.LOLTournamentStubV5.
+type TournamentStubV5API interface {
+	// Deprecated: Use CreateTournamentCodeWithOptions instead.
+	CreateTournamentCode(ctx context.Context, route api.RegionalRoute, body *TournamentStubCodeParametersV5DTO, count int, tournamentId int) ([]string, error)
+	CreateTournamentCodeWithOptions(ctx context.Context, route api.RegionalRoute, body *TournamentStubCodeParametersV5DTO, tournamentId int, opts *TournamentStubV5CreateTournamentCodeOptions) ([]string, error)
+	LobbyEventsByCode(ctx context.Context, route api.RegionalRoute, tournamentCode string) (*TournamentStubLobbyEventWrapperV5DTO, error)
+	RegisterProviderData(ctx context.Context, route api.RegionalRoute, body *TournamentStubProviderRegistrationParametersV5DTO) (int, error)
+	RegisterTournament(ctx context.Context, route api.RegionalRoute, body *TournamentStubRegistrationParametersV5DTO) (int, error)
+	TournamentCode(ctx context.Context, route api.RegionalRoute, tournamentCode string) (*TournamentStubCodeV5DTO, error)
+}
+
+// Methods of TournamentV5, implemented by *TournamentV5 and equinoxtest.LOLTournamentV5.
+type TournamentV5API interface {
+	// Deprecated: Use CreateTournamentCodeWithOptions instead.
+	CreateTournamentCode(ctx context.Context, route api.RegionalRoute, body *TournamentCodeParametersV5DTO, tournamentId int, count int) ([]string, error)
+	CreateTournamentCodeWithOptions(ctx context.Context, route api.RegionalRoute, body *TournamentCodeParametersV5DTO, tournamentId int, opts *TournamentV5CreateTournamentCodeOptions) ([]string, error)
+	Games(ctx context.Context, route api.RegionalRoute, tournamentCode string) ([]TournamentGamesV5DTO, error)
+	LobbyEventsByCode(ctx context.Context, route api.RegionalRoute, tournamentCode string) (*TournamentLobbyEventWrapperV5DTO, error)
+	RegisterProviderData(ctx context.Context, route api.RegionalRoute, body *TournamentProviderRegistrationParametersV5DTO) (int, error)
+	RegisterTournament(ctx context.Context, route api.RegionalRoute, body *TournamentRegistrationParametersV5DTO) (int, error)
+	TournamentCode(ctx context.Context, route api.RegionalRoute, tournamentCode string) (*TournamentCodeV5DTO, error)
+	UpdateCode(ctx context.Context, route api.RegionalRoute, body *TournamentCodeUpdateParametersV5DTO, tournamentCode string) error
+}
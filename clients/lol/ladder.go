@@ -0,0 +1,132 @@
+package lol
+
+import (
+	"context"
+	"iter"
+
+	"github.com/Kyagara/equinox/v2/api"
+	"github.com/Kyagara/equinox/v2/internal"
+)
+
+type ladderJob = internal.LadderJob[Tier, Division]
+
+var (
+	apexTiers = []Tier{CHALLENGER, GRANDMASTER, MASTER}
+	// Tiers with divisions, from highest to lowest.
+	divisionTiers = []Tier{DIAMOND, EMERALD, PLATINUM, GOLD, SILVER, BRONZE, IRON}
+	divisions     = []Division{I, II, III, IV}
+)
+
+// Returns an iterator over every entry of a queue's ladder in the platform, from CHALLENGER down to IRON IV.
+//
+// Apex tiers are fetched with ChallengerByQueue, GrandmasterByQueue and MasterByQueue and converted to LeagueEntryV4DTO,
+// divisions are paged with Entries until an empty page, every page is yielded as it arrives. Iteration stops after the first error.
+//
+// A tier/division only starts when the rate limiter has a request left for Entries, see api.LadderOptions.
+func (endpoint *LeagueV4) Ladder(ctx context.Context, route PlatformRoute, queue QueueType, opts *api.LadderOptions) iter.Seq2[LeagueEntryV4DTO, error] {
+	jobs := internal.LadderJobs(apexTiers, "", divisionTiers, divisions)
+	budget := endpoint.internal.RequestBudget(route.String(), "league-v4.getLeagueEntries")
+
+	return internal.FanOut(ctx, budget, jobs, internal.LadderConcurrency(opts), func(ctx context.Context, job ladderJob, send func([]LeagueEntryV4DTO) bool) error {
+		if job.Division == "" {
+			entries, err := endpoint.apexEntries(ctx, route, queue, job.Tier)
+			if err != nil {
+				return err
+			}
+			send(entries)
+			return nil
+		}
+
+		for page := 1; ; page++ {
+			res, err := endpoint.EntriesWithOptions(ctx, route, queue, job.Tier, job.Division, &LeagueV4EntriesOptions{Page: &page})
+			if err != nil {
+				return err
+			}
+			if len(res) == 0 || !send(res) {
+				return nil
+			}
+		}
+	})
+}
+
+func (endpoint *LeagueV4) apexEntries(ctx context.Context, route PlatformRoute, queue QueueType, tier Tier) ([]LeagueEntryV4DTO, error) {
+	var list *LeagueListV4DTO
+	var err error
+	switch tier {
+	case CHALLENGER:
+		list, err = endpoint.ChallengerByQueue(ctx, route, queue)
+	case GRANDMASTER:
+		list, err = endpoint.GrandmasterByQueue(ctx, route, queue)
+	default:
+		list, err = endpoint.MasterByQueue(ctx, route, queue)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]LeagueEntryV4DTO, 0, len(list.Entries))
+	for _, item := range list.Entries {
+		entries = append(entries, LeagueEntryV4DTO{
+			LeagueID:     list.LeagueID,
+			PUUID:        item.PUUID,
+			QueueType:    queue,
+			Rank:         item.Rank,
+			SummonerID:   item.SummonerID,
+			Tier:         tier,
+			MiniSeries:   item.MiniSeries,
+			LeaguePoints: item.LeaguePoints,
+			Losses:       item.Losses,
+			Wins:         item.Wins,
+			FreshBlood:   item.FreshBlood,
+			HotStreak:    item.HotStreak,
+			Inactive:     item.Inactive,
+			Veteran:      item.Veteran,
+		})
+	}
+	return entries, nil
+}
+
+// Returns an iterator over every entry of a queue's ladder in the platform, from CHALLENGER down to IRON IV.
+//
+// Every tier is paged with Entries until an empty page, apex tiers use division I, every page is yielded as it arrives. Iteration stops after the first error.
+//
+// A tier/division only starts when the rate limiter has a request left for Entries, see api.LadderOptions.
+func (endpoint *LeagueExpV4) Ladder(ctx context.Context, route PlatformRoute, queue QueueType, opts *api.LadderOptions) iter.Seq2[LeagueEntryV4DTO, error] {
+	jobs := internal.LadderJobs(apexTiers, I, divisionTiers, divisions)
+	budget := endpoint.internal.RequestBudget(route.String(), "league-exp-v4.getLeagueEntries")
+
+	return internal.FanOut(ctx, budget, jobs, internal.LadderConcurrency(opts), func(ctx context.Context, job ladderJob, send func([]LeagueEntryV4DTO) bool) error {
+		for page := 1; ; page++ {
+			res, err := endpoint.EntriesWithOptions(ctx, route, queue, job.Tier, job.Division, &LeagueExpV4EntriesOptions{Page: &page})
+			if err != nil {
+				return err
+			}
+			if len(res) == 0 {
+				return nil
+			}
+
+			entries := make([]LeagueEntryV4DTO, 0, len(res))
+			for _, entry := range res {
+				entries = append(entries, LeagueEntryV4DTO{
+					LeagueID:     entry.LeagueID,
+					PUUID:        entry.PUUID,
+					QueueType:    entry.QueueType,
+					Rank:         entry.Rank,
+					SummonerID:   entry.SummonerID,
+					Tier:         entry.Tier,
+					MiniSeries:   LeagueMiniSeriesV4DTO(entry.MiniSeries),
+					LeaguePoints: entry.LeaguePoints,
+					Losses:       entry.Losses,
+					Wins:         entry.Wins,
+					FreshBlood:   entry.FreshBlood,
+					HotStreak:    entry.HotStreak,
+					Inactive:     entry.Inactive,
+					Veteran:      entry.Veteran,
+				})
+			}
+			if !send(entries) {
+				return nil
+			}
+		}
+	})
+}
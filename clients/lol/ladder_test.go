@@ -0,0 +1,112 @@
+package lol_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Kyagara/equinox/v2"
+	"github.com/Kyagara/equinox/v2/api"
+	"github.com/Kyagara/equinox/v2/clients/lol"
+	"github.com/Kyagara/equinox/v2/test/util"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLeagueLadder(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterNoResponder(httpmock.NewStringResponder(200, `[]`))
+	httpmock.RegisterResponder("GET", "https://br1.api.riotgames.com/lol/league/v4/challengerleagues/by-queue/RANKED_SOLO_5x5",
+		httpmock.NewStringResponder(200, `{"leagueId":"challenger","tier":"CHALLENGER","entries":[{"puuid":"a","rank":"I","leaguePoints":1000}]}`))
+	httpmock.RegisterResponder("GET", "https://br1.api.riotgames.com/lol/league/v4/grandmasterleagues/by-queue/RANKED_SOLO_5x5",
+		httpmock.NewStringResponder(200, `{"leagueId":"grandmaster","tier":"GRANDMASTER","entries":[]}`))
+	httpmock.RegisterResponder("GET", "https://br1.api.riotgames.com/lol/league/v4/masterleagues/by-queue/RANKED_SOLO_5x5",
+		httpmock.NewStringResponder(200, `{"leagueId":"master","tier":"MASTER","entries":[{"puuid":"b","rank":"I","leaguePoints":10}]}`))
+	httpmock.RegisterResponderWithQuery("GET", "https://br1.api.riotgames.com/lol/league/v4/entries/RANKED_SOLO_5x5/DIAMOND/I", "page=1",
+		httpmock.NewStringResponder(200, `[{"puuid":"c","tier":"DIAMOND","rank":"I","queueType":"RANKED_SOLO_5x5"}]`))
+
+	config := util.NewTestEquinoxConfig()
+	client, err := equinox.NewCustomClient(config, nil, nil, nil)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	entries := []lol.LeagueEntryV4DTO{}
+	for entry, err := range client.LOL.LeagueV4.Ladder(ctx, lol.BR1, lol.RANKED_SOLO_5X5_QUEUETYPE, &api.LadderOptions{Concurrency: 4}) {
+		require.NoError(t, err)
+		entries = append(entries, entry)
+	}
+	require.Len(t, entries, 3)
+	require.Equal(t, lol.LeagueEntryV4DTO{LeagueID: "challenger", PUUID: "a", QueueType: lol.RANKED_SOLO_5X5_QUEUETYPE, Rank: lol.I, Tier: lol.CHALLENGER, LeaguePoints: 1000}, entries[0])
+	require.Equal(t, "b", entries[1].PUUID)
+	require.Equal(t, lol.MASTER, entries[1].Tier)
+	require.Equal(t, "c", entries[2].PUUID)
+
+	// 3 apex lists, 28 divisions and the second page of DIAMOND I
+	require.Equal(t, 3+28+1, httpmock.GetTotalCallCount())
+}
+
+func TestLeagueExpLadder(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	url := "https://br1.api.riotgames.com/lol/league-exp/v4/entries/RANKED_SOLO_5x5/"
+	httpmock.RegisterNoResponder(httpmock.NewStringResponder(200, `[]`))
+	httpmock.RegisterResponderWithQuery("GET", url+"CHALLENGER/I", "page=1",
+		httpmock.NewStringResponder(200, `[{"leagueId":"challenger","puuid":"a","tier":"CHALLENGER","rank":"I","queueType":"RANKED_SOLO_5x5","leaguePoints":1000,"miniSeries":{"target":3}}]`))
+	httpmock.RegisterResponderWithQuery("GET", url+"CHALLENGER/I", "page=2",
+		httpmock.NewStringResponder(200, `[{"leagueId":"challenger","puuid":"b","tier":"CHALLENGER","rank":"I","queueType":"RANKED_SOLO_5x5","leaguePoints":900}]`))
+	httpmock.RegisterResponderWithQuery("GET", url+"IRON/IV", "page=1",
+		httpmock.NewStringResponder(200, `[{"leagueId":"iron","puuid":"c","tier":"IRON","rank":"IV","queueType":"RANKED_SOLO_5x5"}]`))
+
+	config := util.NewTestEquinoxConfig()
+	client, err := equinox.NewCustomClient(config, nil, nil, nil)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	entries := []lol.LeagueEntryV4DTO{}
+	for entry, err := range client.LOL.LeagueExpV4.Ladder(ctx, lol.BR1, lol.RANKED_SOLO_5X5_QUEUETYPE, nil) {
+		require.NoError(t, err)
+		entries = append(entries, entry)
+	}
+	require.Len(t, entries, 3)
+	require.Equal(t, lol.LeagueEntryV4DTO{
+		LeagueID:     "challenger",
+		PUUID:        "a",
+		QueueType:    lol.RANKED_SOLO_5X5_QUEUETYPE,
+		Rank:         lol.I,
+		Tier:         lol.CHALLENGER,
+		MiniSeries:   lol.LeagueMiniSeriesV4DTO{Target: 3},
+		LeaguePoints: 1000,
+	}, entries[0])
+	require.Equal(t, "b", entries[1].PUUID)
+	require.Equal(t, "c", entries[2].PUUID)
+	require.Equal(t, lol.IRON, entries[2].Tier)
+
+	// Apex tiers are paged like divisions, 31 tiers/divisions and the second pages of CHALLENGER I and IRON IV
+	require.Equal(t, 31+2+1, httpmock.GetTotalCallCount())
+}
+
+func TestLeagueLadderError(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterNoResponder(httpmock.NewStringResponder(200, `[]`))
+	httpmock.RegisterResponder("GET", "https://br1.api.riotgames.com/lol/league-exp/v4/entries/RANKED_SOLO_5x5/CHALLENGER/I",
+		httpmock.NewStringResponder(403, `{}`))
+
+	config := util.NewTestEquinoxConfig()
+	client, err := equinox.NewCustomClient(config, nil, nil, nil)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	count := 0
+	for _, err := range client.LOL.LeagueExpV4.Ladder(ctx, lol.BR1, lol.RANKED_SOLO_5X5_QUEUETYPE, &api.LadderOptions{Concurrency: 2}) {
+		require.ErrorIs(t, err, api.ErrForbidden)
+		count++
+	}
+	require.Equal(t, 1, count)
+}
@@ -0,0 +1,134 @@
+package lol
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Returns the participant with the PUUID, false if the player is not in the match.
+func (m *MatchV5DTO) Participant(puuid string) (*MatchParticipantV5DTO, bool) {
+	for i := range m.Info.Participants {
+		if m.Info.Participants[i].PUUID == puuid {
+			return &m.Info.Participants[i], true
+		}
+	}
+	return nil, false
+}
+
+// Returns the participants of a team.
+func (m *MatchV5DTO) TeamParticipants(team Team) []*MatchParticipantV5DTO {
+	var participants []*MatchParticipantV5DTO
+	for i := range m.Info.Participants {
+		if m.Info.Participants[i].TeamID == team {
+			participants = append(participants, &m.Info.Participants[i])
+		}
+	}
+	return participants
+}
+
+// Returns the team that won the match, false if no team won, e.g. in a remake.
+//
+// Teams is used when present, otherwise the participants' Win field.
+func (m *MatchV5DTO) WinningTeam() (Team, bool) {
+	for _, team := range m.Info.Teams {
+		if team.Win {
+			return team.TeamID, true
+		}
+	}
+	for _, participant := range m.Info.Participants {
+		if participant.Win {
+			return participant.TeamID, true
+		}
+	}
+	return ZERO, false
+}
+
+// Returns the participant in the same TeamPosition on the other team, false if the player is not in the match,
+// has no TeamPosition (e.g. ARAM) or no opponent was found.
+func (m *MatchV5DTO) LaneOpponent(puuid string) (*MatchParticipantV5DTO, bool) {
+	player, ok := m.Participant(puuid)
+	if !ok || player.TeamPosition == "" {
+		return nil, false
+	}
+	for i := range m.Info.Participants {
+		opponent := &m.Info.Participants[i]
+		if opponent.TeamID != player.TeamID && opponent.TeamPosition == player.TeamPosition {
+			return opponent, true
+		}
+	}
+	return nil, false
+}
+
+// Returns the length of the match.
+//
+// Prior to patch 11.20, GameDuration is in milliseconds and GameEndTimestamp is not present, afterwards it is in seconds.
+func (info *MatchInfoV5DTO) Duration() time.Duration {
+	if info.GameEndTimestamp == 0 {
+		return time.Duration(info.GameDuration) * time.Millisecond
+	}
+	return time.Duration(info.GameDuration) * time.Second
+}
+
+// Returns the major and minor version of the patch the match was played on, such as 14 and 7 in '14.7.571.9528'.
+func (info *MatchInfoV5DTO) Patch() (major int, minor int, ok bool) {
+	parts := strings.SplitN(info.GameVersion, ".", 3)
+	if len(parts) < 2 {
+		return 0, 0, false
+	}
+	major, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return 0, 0, false
+	}
+	minor, err = strconv.Atoi(parts[1])
+	if err != nil {
+		return 0, 0, false
+	}
+	return major, minor, true
+}
+
+// Returns true if the participants' ChampionID can be trusted.
+//
+// Prior to patch 11.4, ChampionID returned invalid IDs, ChampionName should be used instead. Unknown versions are not trusted.
+func (info *MatchInfoV5DTO) ChampionIDValid() bool {
+	major, minor, ok := info.Patch()
+	if !ok {
+		return false
+	}
+	return major > 11 || (major == 11 && minor >= 4)
+}
+
+// Returns the player's Riot ID game name, falling back to the deprecated RiotIDName used prior to patch 14.5.
+func (p *MatchParticipantV5DTO) GameName() string {
+	if p.RiotIDGameName != "" {
+		return p.RiotIDGameName
+	}
+	return p.RiotIDName
+}
+
+// Returns the player's Riot ID in the 'name#tagline' format, or only the game name if the tagline is missing.
+func (p *MatchParticipantV5DTO) RiotID() string {
+	name := p.GameName()
+	if p.RiotIDTagline == "" {
+		return name
+	}
+	return name + "#" + p.RiotIDTagline
+}
+
+// Returns (kills + assists) / deaths, deaths are counted as 1 when the player did not die.
+func (p *MatchParticipantV5DTO) KDA() float64 {
+	return float64(p.Kills+p.Assists) / float64(max(p.Deaths, 1))
+}
+
+// Returns the creep score, lane minions plus neutral monsters killed.
+func (p *MatchParticipantV5DTO) CS() int {
+	return p.TotalMinionsKilled + p.NeutralMinionsKilled
+}
+
+// Returns the creep score per minute over the duration, usually MatchInfoV5DTO.Duration. Returns 0 if the duration is not positive.
+func (p *MatchParticipantV5DTO) CSPerMinute(duration time.Duration) float64 {
+	if duration <= 0 {
+		return 0
+	}
+	return float64(p.CS()) / duration.Minutes()
+}
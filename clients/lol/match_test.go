@@ -0,0 +1,51 @@
+package lol_test
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/Kyagara/equinox/v2/clients/lol"
+	jsonv2 "github.com/go-json-experiment/json"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMatchHelpers(t *testing.T) {
+	data, err := os.ReadFile("../../test/data/match-v5.getMatch.json")
+	require.NoError(t, err)
+
+	var match lol.MatchV5DTO
+	err = jsonv2.Unmarshal(data, &match)
+	require.NoError(t, err)
+
+	puuid := "px4lJ55eMYD9sHII546P5Wr2MEttCfsSDqk1Gotu8tRs9WLMTAtMaUZuSSVVdXMgzGz4gKctN93HYA"
+	player, ok := match.Participant(puuid)
+	require.True(t, ok)
+	require.Equal(t, "Olaf", player.ChampionName)
+	require.Equal(t, "Sylvie#77777", player.RiotID())
+	require.Equal(t, 2.8, player.KDA())
+	require.Equal(t, 174, player.CS())
+	require.InDelta(t, 6.0, player.CSPerMinute(match.Info.Duration()), 0.01)
+
+	_, ok = match.Participant("unknown")
+	require.False(t, ok)
+
+	opponent, ok := match.LaneOpponent(puuid)
+	require.True(t, ok)
+	require.Equal(t, "Viego", opponent.ChampionName)
+
+	winner, ok := match.WinningTeam()
+	require.True(t, ok)
+	require.Equal(t, lol.RED, winner)
+	require.Len(t, match.TeamParticipants(lol.BLUE), 5)
+
+	require.Equal(t, 1739*time.Second, match.Info.Duration())
+	require.True(t, match.Info.ChampionIDValid())
+
+	old := lol.MatchInfoV5DTO{GameVersion: "11.3.358.5356", GameDuration: 1739000}
+	require.False(t, old.ChampionIDValid())
+	require.Equal(t, 1739*time.Second, old.Duration())
+
+	deprecated := lol.MatchParticipantV5DTO{RiotIDName: "Sylvie"}
+	require.Equal(t, "Sylvie", deprecated.GameName())
+}
@@ -599,18 +599,18 @@ type MatchMetadataV5DTO struct {
 
 // match-v5.MissionsDto
 type MatchMissionsV5DTO struct {
-	PlayerScore0  float64 `json:"playerScore0,omitempty"`
-	PlayerScore1  float64 `json:"playerScore1,omitempty"`
-	PlayerScore10 float64 `json:"playerScore10,omitempty"`
-	PlayerScore11 float64 `json:"playerScore11,omitempty"`
-	PlayerScore2  float64 `json:"playerScore2,omitempty"`
-	PlayerScore3  float64 `json:"playerScore3,omitempty"`
-	PlayerScore4  float64 `json:"playerScore4,omitempty"`
-	PlayerScore5  float64 `json:"playerScore5,omitempty"`
-	PlayerScore6  float64 `json:"playerScore6,omitempty"`
-	PlayerScore7  float64 `json:"playerScore7,omitempty"`
-	PlayerScore8  float64 `json:"playerScore8,omitempty"`
-	PlayerScore9  float64 `json:"playerScore9,omitempty"`
+	PlayerScore0  float64 `json:"PlayerScore0,omitempty"`
+	PlayerScore1  float64 `json:"PlayerScore1,omitempty"`
+	PlayerScore10 float64 `json:"PlayerScore10,omitempty"`
+	PlayerScore11 float64 `json:"PlayerScore11,omitempty"`
+	PlayerScore2  float64 `json:"PlayerScore2,omitempty"`
+	PlayerScore3  float64 `json:"PlayerScore3,omitempty"`
+	PlayerScore4  float64 `json:"PlayerScore4,omitempty"`
+	PlayerScore5  float64 `json:"PlayerScore5,omitempty"`
+	PlayerScore6  float64 `json:"PlayerScore6,omitempty"`
+	PlayerScore7  float64 `json:"PlayerScore7,omitempty"`
+	PlayerScore8  float64 `json:"PlayerScore8,omitempty"`
+	PlayerScore9  float64 `json:"PlayerScore9,omitempty"`
 }
 
 // match-v5.ObjectiveDto
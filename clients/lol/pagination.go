@@ -0,0 +1,44 @@
+package lol
+
+import (
+	"context"
+	"iter"
+
+	"github.com/Kyagara/equinox/v2/api"
+	"github.com/Kyagara/equinox/v2/internal"
+)
+
+// Maximum number of match ids returned per page by MatchV5 and RsoMatchV1.
+const MATCH_IDS_PAGE_SIZE = 100
+
+// Returns an iterator over all match ids of a player, requesting pages of opts.Count (or MATCH_IDS_PAGE_SIZE) ids until a page comes back short.
+//
+// opts.Start sets the first index, time windows and filters are sent with every page. Iteration stops after the first error, including context cancellation.
+func (endpoint *MatchV5) AllIDsByPUUID(ctx context.Context, route api.RegionalRoute, puuid string, opts *MatchV5ListByPUUIDOptions) iter.Seq2[string, error] {
+	var page MatchV5ListByPUUIDOptions
+	if opts != nil {
+		page = *opts
+	}
+	start, count := internal.PageBounds(page.Start, page.Count, MATCH_IDS_PAGE_SIZE)
+	return internal.Paginate(ctx, start, count, func(ctx context.Context, start int, count int) ([]string, error) {
+		page := page
+		page.Start, page.Count = &start, &count
+		return endpoint.ListByPUUIDWithOptions(ctx, route, puuid, &page)
+	})
+}
+
+// Returns an iterator over all match ids of the player owning the access token, requesting pages of opts.Count (or MATCH_IDS_PAGE_SIZE) ids until a page comes back short.
+//
+// opts.Start sets the first index, time windows and filters are sent with every page. Iteration stops after the first error, including context cancellation.
+func (endpoint *RsoMatchV1) AllMatchIds(ctx context.Context, route api.RegionalRoute, accessToken string, opts *RsoMatchV1MatchIdsOptions) iter.Seq2[string, error] {
+	var page RsoMatchV1MatchIdsOptions
+	if opts != nil {
+		page = *opts
+	}
+	start, count := internal.PageBounds(page.Start, page.Count, MATCH_IDS_PAGE_SIZE)
+	return internal.Paginate(ctx, start, count, func(ctx context.Context, start int, count int) ([]string, error) {
+		page := page
+		page.Start, page.Count = &start, &count
+		return endpoint.MatchIdsWithOptions(ctx, route, accessToken, &page)
+	})
+}
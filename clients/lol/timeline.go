@@ -0,0 +1,399 @@
+package lol
+
+import (
+	"iter"
+	"slices"
+)
+
+// CHAMPION_KILL event.
+type KillEvent struct {
+	Position                MatchPositionV5DTO
+	AssistingParticipantIDs []int
+	VictimDamageDealt       []MatchTimelineVictimDamageV5DTO
+	VictimDamageReceived    []MatchTimelineVictimDamageV5DTO
+	Timestamp               int
+	// 0 if the champion was executed.
+	KillerID         int
+	VictimID         int
+	Bounty           int
+	ShutdownBounty   int
+	KillStreakLength int
+}
+
+// CHAMPION_SPECIAL_KILL event.
+type SpecialKillEvent struct {
+	Position MatchPositionV5DTO
+	// KILL_FIRST_BLOOD, KILL_MULTI or KILL_ACE.
+	KillType        string
+	Timestamp       int
+	KillerID        int
+	MultiKillLength int
+}
+
+// ELITE_MONSTER_KILL event.
+type EliteMonsterKillEvent struct {
+	Position                MatchPositionV5DTO
+	AssistingParticipantIDs []int
+	// DRAGON, BARON_NASHOR, RIFTHERALD, HORDE or ATAKHAN.
+	MonsterType string
+	// Dragon type, e.g. FIRE_DRAGON, empty for other monsters.
+	MonsterSubType string
+	Timestamp      int
+	KillerID       int
+	KillerTeamID   Team
+	Bounty         int
+}
+
+// BUILDING_KILL event.
+type BuildingKillEvent struct {
+	Position                MatchPositionV5DTO
+	AssistingParticipantIDs []int
+	// TOWER_BUILDING or INHIBITOR_BUILDING.
+	BuildingType string
+	LaneType     string
+	// Empty for inhibitors.
+	TowerType string
+	Timestamp int
+	KillerID  int
+	// Team that owned the building.
+	TeamID Team
+	Bounty int
+}
+
+// TURRET_PLATE_DESTROYED event.
+type TurretPlateDestroyedEvent struct {
+	Position  MatchPositionV5DTO
+	LaneType  string
+	Timestamp int
+	KillerID  int
+	// Team that owned the turret.
+	TeamID Team
+}
+
+// ITEM_PURCHASED event.
+type ItemPurchasedEvent struct {
+	Timestamp     int
+	ParticipantID int
+	ItemID        int
+}
+
+// ITEM_SOLD event.
+type ItemSoldEvent struct {
+	Timestamp     int
+	ParticipantID int
+	ItemID        int
+}
+
+// ITEM_DESTROYED event.
+type ItemDestroyedEvent struct {
+	Timestamp     int
+	ParticipantID int
+	ItemID        int
+}
+
+// ITEM_UNDO event.
+type ItemUndoEvent struct {
+	Timestamp     int
+	ParticipantID int
+	// Item ID before the undo, 0 if undoing a purchase.
+	BeforeID int
+	// Item ID after the undo, 0 if undoing a sale.
+	AfterID  int
+	GoldGain int
+}
+
+// WARD_PLACED event.
+type WardPlacedEvent struct {
+	WardType  string
+	Timestamp int
+	CreatorID int
+}
+
+// WARD_KILL event.
+type WardKillEvent struct {
+	WardType  string
+	Timestamp int
+	KillerID  int
+}
+
+// LEVEL_UP event.
+type LevelUpEvent struct {
+	Timestamp     int
+	ParticipantID int
+	Level         int
+}
+
+// SKILL_LEVEL_UP event.
+type SkillLevelUpEvent struct {
+	// NORMAL or EVOLVE.
+	LevelUpType   string
+	Timestamp     int
+	ParticipantID int
+	SkillSlot     int
+}
+
+// GAME_END event.
+type GameEndEvent struct {
+	Timestamp     int
+	RealTimestamp int
+	GameID        int
+	WinningTeam   Team
+}
+
+// Returns the event as a KillEvent, false if the event is not CHAMPION_KILL.
+func (e *MatchEventsTimeLineV5DTO) AsKill() (KillEvent, bool) {
+	if EventType(e.Type) != CHAMPION_KILL_EVENTTYPE {
+		return KillEvent{}, false
+	}
+	return KillEvent{
+		Position:                e.Position,
+		AssistingParticipantIDs: e.AssistingParticipantIDs,
+		VictimDamageDealt:       e.VictimDamageDealt,
+		VictimDamageReceived:    e.VictimDamageReceived,
+		Timestamp:               e.Timestamp,
+		KillerID:                e.KillerID,
+		VictimID:                e.VictimID,
+		Bounty:                  e.Bounty,
+		ShutdownBounty:          e.ShutdownBounty,
+		KillStreakLength:        e.KillStreakLength,
+	}, true
+}
+
+// Returns the event as a SpecialKillEvent, false if the event is not CHAMPION_SPECIAL_KILL.
+func (e *MatchEventsTimeLineV5DTO) AsSpecialKill() (SpecialKillEvent, bool) {
+	if EventType(e.Type) != CHAMPION_SPECIAL_KILL_EVENTTYPE {
+		return SpecialKillEvent{}, false
+	}
+	return SpecialKillEvent{
+		Position:        e.Position,
+		KillType:        e.KillType,
+		Timestamp:       e.Timestamp,
+		KillerID:        e.KillerID,
+		MultiKillLength: e.MultiKillLength,
+	}, true
+}
+
+// Returns the event as an EliteMonsterKillEvent, false if the event is not ELITE_MONSTER_KILL.
+func (e *MatchEventsTimeLineV5DTO) AsEliteMonsterKill() (EliteMonsterKillEvent, bool) {
+	if EventType(e.Type) != ELITE_MONSTER_KILL_EVENTTYPE {
+		return EliteMonsterKillEvent{}, false
+	}
+	return EliteMonsterKillEvent{
+		Position:                e.Position,
+		AssistingParticipantIDs: e.AssistingParticipantIDs,
+		MonsterType:             e.MonsterType,
+		MonsterSubType:          e.MonsterSubType,
+		Timestamp:               e.Timestamp,
+		KillerID:                e.KillerID,
+		KillerTeamID:            e.KillerTeamID,
+		Bounty:                  e.Bounty,
+	}, true
+}
+
+// Returns the event as a BuildingKillEvent, false if the event is not BUILDING_KILL.
+func (e *MatchEventsTimeLineV5DTO) AsBuildingKill() (BuildingKillEvent, bool) {
+	if EventType(e.Type) != BUILDING_KILL_EVENTTYPE {
+		return BuildingKillEvent{}, false
+	}
+	return BuildingKillEvent{
+		Position:                e.Position,
+		AssistingParticipantIDs: e.AssistingParticipantIDs,
+		BuildingType:            e.BuildingType,
+		LaneType:                e.LaneType,
+		TowerType:               e.TowerType,
+		Timestamp:               e.Timestamp,
+		KillerID:                e.KillerID,
+		TeamID:                  e.TeamID,
+		Bounty:                  e.Bounty,
+	}, true
+}
+
+// Returns the event as a TurretPlateDestroyedEvent, false if the event is not TURRET_PLATE_DESTROYED.
+func (e *MatchEventsTimeLineV5DTO) AsTurretPlateDestroyed() (TurretPlateDestroyedEvent, bool) {
+	if EventType(e.Type) != TURRET_PLATE_DESTROYED_EVENTTYPE {
+		return TurretPlateDestroyedEvent{}, false
+	}
+	return TurretPlateDestroyedEvent{
+		Position:  e.Position,
+		LaneType:  e.LaneType,
+		Timestamp: e.Timestamp,
+		KillerID:  e.KillerID,
+		TeamID:    e.TeamID,
+	}, true
+}
+
+// Returns the event as an ItemPurchasedEvent, false if the event is not ITEM_PURCHASED.
+func (e *MatchEventsTimeLineV5DTO) AsItemPurchased() (ItemPurchasedEvent, bool) {
+	if EventType(e.Type) != ITEM_PURCHASED_EVENTTYPE {
+		return ItemPurchasedEvent{}, false
+	}
+	return ItemPurchasedEvent{Timestamp: e.Timestamp, ParticipantID: e.ParticipantID, ItemID: e.ItemID}, true
+}
+
+// Returns the event as an ItemSoldEvent, false if the event is not ITEM_SOLD.
+func (e *MatchEventsTimeLineV5DTO) AsItemSold() (ItemSoldEvent, bool) {
+	if EventType(e.Type) != ITEM_SOLD_EVENTTYPE {
+		return ItemSoldEvent{}, false
+	}
+	return ItemSoldEvent{Timestamp: e.Timestamp, ParticipantID: e.ParticipantID, ItemID: e.ItemID}, true
+}
+
+// Returns the event as an ItemDestroyedEvent, false if the event is not ITEM_DESTROYED.
+func (e *MatchEventsTimeLineV5DTO) AsItemDestroyed() (ItemDestroyedEvent, bool) {
+	if EventType(e.Type) != ITEM_DESTROYED_EVENTTYPE {
+		return ItemDestroyedEvent{}, false
+	}
+	return ItemDestroyedEvent{Timestamp: e.Timestamp, ParticipantID: e.ParticipantID, ItemID: e.ItemID}, true
+}
+
+// Returns the event as an ItemUndoEvent, false if the event is not ITEM_UNDO.
+func (e *MatchEventsTimeLineV5DTO) AsItemUndo() (ItemUndoEvent, bool) {
+	if EventType(e.Type) != ITEM_UNDO_EVENTTYPE {
+		return ItemUndoEvent{}, false
+	}
+	return ItemUndoEvent{
+		Timestamp:     e.Timestamp,
+		ParticipantID: e.ParticipantID,
+		BeforeID:      e.BeforeID,
+		AfterID:       e.AfterID,
+		GoldGain:      e.GoldGain,
+	}, true
+}
+
+// Returns the event as a WardPlacedEvent, false if the event is not WARD_PLACED.
+func (e *MatchEventsTimeLineV5DTO) AsWardPlaced() (WardPlacedEvent, bool) {
+	if EventType(e.Type) != WARD_PLACED_EVENTTYPE {
+		return WardPlacedEvent{}, false
+	}
+	return WardPlacedEvent{WardType: e.WardType, Timestamp: e.Timestamp, CreatorID: e.CreatorID}, true
+}
+
+// Returns the event as a WardKillEvent, false if the event is not WARD_KILL.
+func (e *MatchEventsTimeLineV5DTO) AsWardKill() (WardKillEvent, bool) {
+	if EventType(e.Type) != WARD_KILL_EVENTTYPE {
+		return WardKillEvent{}, false
+	}
+	return WardKillEvent{WardType: e.WardType, Timestamp: e.Timestamp, KillerID: e.KillerID}, true
+}
+
+// Returns the event as a LevelUpEvent, false if the event is not LEVEL_UP.
+func (e *MatchEventsTimeLineV5DTO) AsLevelUp() (LevelUpEvent, bool) {
+	if EventType(e.Type) != LEVEL_UP_EVENTTYPE {
+		return LevelUpEvent{}, false
+	}
+	return LevelUpEvent{Timestamp: e.Timestamp, ParticipantID: e.ParticipantID, Level: e.Level}, true
+}
+
+// Returns the event as a SkillLevelUpEvent, false if the event is not SKILL_LEVEL_UP.
+func (e *MatchEventsTimeLineV5DTO) AsSkillLevelUp() (SkillLevelUpEvent, bool) {
+	if EventType(e.Type) != SKILL_LEVEL_UP_EVENTTYPE {
+		return SkillLevelUpEvent{}, false
+	}
+	return SkillLevelUpEvent{
+		LevelUpType:   e.LevelUpType,
+		Timestamp:     e.Timestamp,
+		ParticipantID: e.ParticipantID,
+		SkillSlot:     e.SkillSlot,
+	}, true
+}
+
+// Returns the event as a GameEndEvent, false if the event is not GAME_END.
+func (e *MatchEventsTimeLineV5DTO) AsGameEnd() (GameEndEvent, bool) {
+	if EventType(e.Type) != GAME_END_EVENTTYPE {
+		return GameEndEvent{}, false
+	}
+	return GameEndEvent{
+		Timestamp:     e.Timestamp,
+		RealTimestamp: e.RealTimestamp,
+		GameID:        e.GameID,
+		WinningTeam:   Team(e.WinningTeam),
+	}, true
+}
+
+// Returns an iterator over the events of every frame, in order, filtered by type. No types returns all events.
+func (t *MatchTimelineV5DTO) Events(types ...EventType) iter.Seq[*MatchEventsTimeLineV5DTO] {
+	return func(yield func(*MatchEventsTimeLineV5DTO) bool) {
+		for i := range t.Info.Frames {
+			frame := &t.Info.Frames[i]
+			for j := range frame.Events {
+				event := &frame.Events[j]
+				if len(types) > 0 && !slices.Contains(types, EventType(event.Type)) {
+					continue
+				}
+				if !yield(event) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Returns an iterator over the CHAMPION_KILL events.
+func (t *MatchTimelineV5DTO) Kills() iter.Seq[KillEvent] {
+	return eventsAs(t, CHAMPION_KILL_EVENTTYPE, (*MatchEventsTimeLineV5DTO).AsKill)
+}
+
+// Returns an iterator over the ELITE_MONSTER_KILL events.
+func (t *MatchTimelineV5DTO) EliteMonsterKills() iter.Seq[EliteMonsterKillEvent] {
+	return eventsAs(t, ELITE_MONSTER_KILL_EVENTTYPE, (*MatchEventsTimeLineV5DTO).AsEliteMonsterKill)
+}
+
+// Returns an iterator over the BUILDING_KILL events.
+func (t *MatchTimelineV5DTO) BuildingKills() iter.Seq[BuildingKillEvent] {
+	return eventsAs(t, BUILDING_KILL_EVENTTYPE, (*MatchEventsTimeLineV5DTO).AsBuildingKill)
+}
+
+// Returns an iterator over the ITEM_PURCHASED events.
+func (t *MatchTimelineV5DTO) ItemsPurchased() iter.Seq[ItemPurchasedEvent] {
+	return eventsAs(t, ITEM_PURCHASED_EVENTTYPE, (*MatchEventsTimeLineV5DTO).AsItemPurchased)
+}
+
+func eventsAs[T any](t *MatchTimelineV5DTO, eventType EventType, as func(*MatchEventsTimeLineV5DTO) (T, bool)) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for event := range t.Events(eventType) {
+			if view, ok := as(event); ok && !yield(view) {
+				return
+			}
+		}
+	}
+}
+
+// Totals of both teams in a timeline frame.
+type TeamFrame struct {
+	Timestamp int
+	BlueGold  int
+	RedGold   int
+	BlueXP    int
+	RedXP     int
+}
+
+// Blue team gold minus red team gold.
+func (f TeamFrame) GoldDiff() int {
+	return f.BlueGold - f.RedGold
+}
+
+// Blue team XP minus red team XP.
+func (f TeamFrame) XPDiff() int {
+	return f.BlueXP - f.RedXP
+}
+
+// Returns the total gold and XP of each team for every frame.
+//
+// Participants 1 to 5 are in the BLUE team and 6 to 10 in the RED team, game modes with more teams are not supported.
+func (t *MatchTimelineV5DTO) TeamFrames() []TeamFrame {
+	frames := make([]TeamFrame, 0, len(t.Info.Frames))
+	for _, frame := range t.Info.Frames {
+		teamFrame := TeamFrame{Timestamp: frame.Timestamp}
+		for participantID, participant := range frame.ParticipantFrames {
+			if participantID <= 5 {
+				teamFrame.BlueGold += participant.TotalGold
+				teamFrame.BlueXP += participant.XP
+			} else {
+				teamFrame.RedGold += participant.TotalGold
+				teamFrame.RedXP += participant.XP
+			}
+		}
+		frames = append(frames, teamFrame)
+	}
+	return frames
+}
@@ -0,0 +1,47 @@
+package lol_test
+
+import (
+	"os"
+	"slices"
+	"testing"
+
+	"github.com/Kyagara/equinox/v2/clients/lol"
+	jsonv2 "github.com/go-json-experiment/json"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimelineHelpers(t *testing.T) {
+	data, err := os.ReadFile("../../test/data/match-v5.getTimeline.json")
+	require.NoError(t, err)
+
+	var timeline lol.MatchTimelineV5DTO
+	err = jsonv2.Unmarshal(data, &timeline)
+	require.NoError(t, err)
+
+	kills := slices.Collect(timeline.Kills())
+	require.Len(t, kills, 49)
+	require.Equal(t, 9, kills[0].KillerID)
+	require.Equal(t, 4, kills[0].VictimID)
+	require.Equal(t, []int{8, 10}, kills[0].AssistingParticipantIDs)
+
+	require.Len(t, slices.Collect(timeline.EliteMonsterKills()), 13)
+	require.Len(t, slices.Collect(timeline.BuildingKills()), 11)
+	require.Len(t, slices.Collect(timeline.ItemsPurchased()), 269)
+	require.Len(t, slices.Collect(timeline.Events(lol.WARD_PLACED_EVENTTYPE, lol.WARD_KILL_EVENTTYPE)), 288)
+
+	for event := range timeline.Events(lol.GAME_END_EVENTTYPE) {
+		_, ok := event.AsKill()
+		require.False(t, ok)
+		end, ok := event.AsGameEnd()
+		require.True(t, ok)
+		require.NotZero(t, end.GameID)
+	}
+
+	frames := timeline.TeamFrames()
+	require.Len(t, frames, 30)
+	last := frames[len(frames)-1]
+	require.Equal(t, 56649, last.BlueGold)
+	require.Equal(t, 64250, last.RedGold)
+	require.Equal(t, 56649-64250, last.GoldDiff())
+	require.Equal(t, 70263, last.BlueXP)
+}
@@ -38,3 +38,32 @@ func NewLORClient(client *internal.Client) *Client {
 		StatusV1:    StatusV1{internal: client},
 	}
 }
+
+// Endpoint groups of the LOR Client, implemented by *Client and equinoxtest.LOR.
+type ClientAPI interface {
+	DeckV1API() DeckV1API
+	InventoryV1API() InventoryV1API
+	MatchV1API() MatchV1API
+	RankedV1API() RankedV1API
+	StatusV1API() StatusV1API
+}
+
+func (c *Client) DeckV1API() DeckV1API {
+	return &c.DeckV1
+}
+
+func (c *Client) InventoryV1API() InventoryV1API {
+	return &c.InventoryV1
+}
+
+func (c *Client) MatchV1API() MatchV1API {
+	return &c.MatchV1
+}
+
+func (c *Client) RankedV1API() RankedV1API {
+	return &c.RankedV1
+}
+
+func (c *Client) StatusV1API() StatusV1API {
+	return &c.StatusV1
+}
@@ -0,0 +1,70 @@
+package lor_test
+
+///////////////////////////////////////////////
+//                                           //
+//                     !                     //
+//   This file is automatically generated!   //
+//           Do not directly edit!           //
+//                                           //
+///////////////////////////////////////////////
+
+// Spec version = c5f59a3e27f5101b78b8c7eb9b3fb88318b4225d
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"testing"
+
+	jsonv2 "github.com/go-json-experiment/json"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Kyagara/equinox/v2/clients/lor"
+	"github.com/Kyagara/equinox/v2/test/util"
+)
+
+// Decodes the sample response of every method, 'test/data/<method ID>.json', rejecting unknown members and
+// failing on fields missing from the sample, see util.MissingMembers.
+//
+// Methods without a sample response are skipped, at least one sample is required.
+func TestDecoding(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		MethodID string
+		Target   any
+	}{
+		{"lor-deck-v1.createDeck", new(string)},
+		{"lor-deck-v1.getDecks", new([]lor.DeckV1DTO)},
+		{"lor-inventory-v1.getCards", new([]lor.InventoryCardV1DTO)},
+		{"lor-match-v1.getMatch", new(lor.MatchV1DTO)},
+		{"lor-match-v1.getMatchIdsByPUUID", new([]string)},
+		{"lor-ranked-v1.getLeaderboards", new(lor.RankedLeaderboardV1DTO)},
+		{"lor-status-v1.getPlatformData", new(lor.StatusPlatformDataV1DTO)},
+	}
+
+	samples := 0
+	for _, test := range tests {
+		data, err := os.ReadFile("../../test/data/" + test.MethodID + ".json")
+		if err == nil {
+			samples++
+		}
+
+		t.Run(test.MethodID, func(t *testing.T) {
+			t.Parallel()
+
+			if errors.Is(err, fs.ErrNotExist) {
+				t.Skip("no sample response")
+			}
+			require.NoError(t, err)
+
+			require.NoError(t, jsonv2.Unmarshal(data, test.Target, jsonv2.RejectUnknownMembers(true)))
+
+			missing, err := util.MissingMembers(data, test.Target)
+			require.NoError(t, err)
+			require.Empty(t, missing, "fields missing from the sample response")
+		})
+	}
+
+	require.NotZero(t, samples, "no sample responses in 'test/data' for the lor client")
+}
@@ -0,0 +1,44 @@
+package lor
+
+///////////////////////////////////////////////
+//                                           //
+//                     !                     //
+//   This file is automatically generated!   //
+//           Do not directly edit!           //
+//                                           //
+///////////////////////////////////////////////
+
+// Spec version = c5f59a3e27f5101b78b8c7eb9b3fb88318b4225d
+
+import (
+	"context"
+
+	"github.com/Kyagara/equinox/v2/api"
+)
+
+// Methods of DeckV1, implemented by *DeckV1 and equinoxtest.LORDeckV1.
+type DeckV1API interface {
+	CreateDeck(ctx context.Context, route api.RegionalRoute, accessToken string, body *DeckNewDeckV1DTO) (string, error)
+	Decks(ctx context.Context, route api.RegionalRoute, accessToken string) ([]DeckV1DTO, error)
+}
+
+// Methods of InventoryV1, implemented by *InventoryV1 and equinoxtest.LORInventoryV1.
+type InventoryV1API interface {
+	Cards(ctx context.Context, route api.RegionalRoute, accessToken string) ([]InventoryCardV1DTO, error)
+}
+
+// Methods of MatchV1, implemented by *MatchV1 and equinoxtest.LORMatchV1.
+type MatchV1API interface {
+	ByID(ctx context.Context, route api.RegionalRoute, matchId string) (*MatchV1DTO, error)
+	ListByPUUID(ctx context.Context, route api.RegionalRoute, puuid string) ([]string, error)
+}
+
+// Methods of RankedV1, implemented by *RankedV1 and equinoxtest.LORRankedV1.
+type RankedV1API interface {
+	Leaderboards(ctx context.Context, route api.RegionalRoute) (*RankedLeaderboardV1DTO, error)
+}
+
+// Methods of StatusV1, implemented by *StatusV1 and equinoxtest.LORStatusV1.
+type StatusV1API interface {
+	Platform(ctx context.Context, route api.RegionalRoute) (*StatusPlatformDataV1DTO, error)
+}
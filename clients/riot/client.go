@@ -26,3 +26,12 @@ func NewRiotClient(client *internal.Client) *Client {
 		AccountV1: AccountV1{internal: client},
 	}
 }
+
+// Endpoint groups of the Riot Client, implemented by *Client and equinoxtest.Riot.
+type ClientAPI interface {
+	AccountV1API() AccountV1API
+}
+
+func (c *Client) AccountV1API() AccountV1API {
+	return &c.AccountV1
+}
@@ -0,0 +1,68 @@
+package riot_test
+
+///////////////////////////////////////////////
+//                                           //
+//                     !                     //
+//   This file is automatically generated!   //
+//           Do not directly edit!           //
+//                                           //
+///////////////////////////////////////////////
+
+// Spec version = c5f59a3e27f5101b78b8c7eb9b3fb88318b4225d
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"testing"
+
+	jsonv2 "github.com/go-json-experiment/json"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Kyagara/equinox/v2/clients/riot"
+	"github.com/Kyagara/equinox/v2/test/util"
+)
+
+// Decodes the sample response of every method, 'test/data/<method ID>.json', rejecting unknown members and
+// failing on fields missing from the sample, see util.MissingMembers.
+//
+// Methods without a sample response are skipped, at least one sample is required.
+func TestDecoding(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		MethodID string
+		Target   any
+	}{
+		{"account-v1.getActiveRegion", new(riot.AccountRegionV1DTO)},
+		{"account-v1.getActiveShard", new(riot.AccountActiveShardV1DTO)},
+		{"account-v1.getByAccessToken", new(riot.AccountV1DTO)},
+		{"account-v1.getByPuuid", new(riot.AccountV1DTO)},
+		{"account-v1.getByRiotId", new(riot.AccountV1DTO)},
+	}
+
+	samples := 0
+	for _, test := range tests {
+		data, err := os.ReadFile("../../test/data/" + test.MethodID + ".json")
+		if err == nil {
+			samples++
+		}
+
+		t.Run(test.MethodID, func(t *testing.T) {
+			t.Parallel()
+
+			if errors.Is(err, fs.ErrNotExist) {
+				t.Skip("no sample response")
+			}
+			require.NoError(t, err)
+
+			require.NoError(t, jsonv2.Unmarshal(data, test.Target, jsonv2.RejectUnknownMembers(true)))
+
+			missing, err := util.MissingMembers(data, test.Target)
+			require.NoError(t, err)
+			require.Empty(t, missing, "fields missing from the sample response")
+		})
+	}
+
+	require.NotZero(t, samples, "no sample responses in 'test/data' for the riot client")
+}
@@ -0,0 +1,26 @@
+package riot
+
+///////////////////////////////////////////////
+//                                           //
+//                     !                     //
+//   This file is automatically generated!   //
+//           Do not directly edit!           //
+//                                           //
+///////////////////////////////////////////////
+
+// Spec version = c5f59a3e27f5101b78b8c7eb9b3fb88318b4225d
+
+import (
+	"context"
+
+	"github.com/Kyagara/equinox/v2/api"
+)
+
+// Methods of AccountV1, implemented by *AccountV1 and equinoxtest.RiotAccountV1.
+type AccountV1API interface {
+	ActiveRegion(ctx context.Context, route api.RegionalRoute, game string, puuid string) (*AccountRegionV1DTO, error)
+	ActiveShard(ctx context.Context, route api.RegionalRoute, game string, puuid string) (*AccountActiveShardV1DTO, error)
+	ByAccessToken(ctx context.Context, route api.RegionalRoute, accessToken string) (*AccountV1DTO, error)
+	ByPUUID(ctx context.Context, route api.RegionalRoute, puuid string) (*AccountV1DTO, error)
+	ByRiotID(ctx context.Context, route api.RegionalRoute, gameName string, tagLine string) (*AccountV1DTO, error)
+}
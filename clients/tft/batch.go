@@ -0,0 +1,26 @@
+package tft
+
+import (
+	"context"
+
+	"github.com/Kyagara/equinox/v2/api"
+	"github.com/Kyagara/equinox/v2/internal"
+)
+
+// Fetches every match in matchIDs with ByID, streaming the results in completion order.
+//
+// The channel returned is closed once all matches were fetched or the context is cancelled. See api.BatchOptions for concurrency and progress.
+func (endpoint *MatchV1) BatchByID(ctx context.Context, route api.RegionalRoute, matchIDs []string, opts *api.BatchOptions) <-chan api.BatchResult[*MatchV1DTO] {
+	budget := endpoint.internal.RequestBudget(route.String(), "tft-match-v1.getMatch")
+	return internal.BatchSlice(ctx, budget, matchIDs, opts, func(ctx context.Context, id string) (*MatchV1DTO, error) {
+		return endpoint.ByID(ctx, route, id)
+	})
+}
+
+// Same as BatchByID, but reads match IDs from a channel until it is closed.
+func (endpoint *MatchV1) StreamByID(ctx context.Context, route api.RegionalRoute, matchIDs <-chan string, opts *api.BatchOptions) <-chan api.BatchResult[*MatchV1DTO] {
+	budget := endpoint.internal.RequestBudget(route.String(), "tft-match-v1.getMatch")
+	return internal.Batch(ctx, budget, matchIDs, 0, opts, func(ctx context.Context, id string) (*MatchV1DTO, error) {
+		return endpoint.ByID(ctx, route, id)
+	})
+}
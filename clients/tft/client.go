@@ -38,3 +38,32 @@ func NewTFTClient(client *internal.Client) *Client {
 		SummonerV1:  SummonerV1{internal: client},
 	}
 }
+
+// Endpoint groups of the TFT Client, implemented by *Client and equinoxtest.TFT.
+type ClientAPI interface {
+	SpectatorV5API() SpectatorV5API
+	LeagueV1API() LeagueV1API
+	MatchV1API() MatchV1API
+	StatusV1API() StatusV1API
+	SummonerV1API() SummonerV1API
+}
+
+func (c *Client) SpectatorV5API() SpectatorV5API {
+	return &c.SpectatorV5
+}
+
+func (c *Client) LeagueV1API() LeagueV1API {
+	return &c.LeagueV1
+}
+
+func (c *Client) MatchV1API() MatchV1API {
+	return &c.MatchV1
+}
+
+func (c *Client) StatusV1API() StatusV1API {
+	return &c.StatusV1
+}
+
+func (c *Client) SummonerV1API() SummonerV1API {
+	return &c.SummonerV1
+}
@@ -1,6 +1,12 @@
 package tft
 
-import "strconv"
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/Kyagara/equinox/v2/api"
+)
 
 ///////////////////////////////////////////////
 //                                           //
@@ -101,6 +107,103 @@ func (route PlatformRoute) String() string {
 	}
 }
 
+// Returns the regional route of this platform route, or an empty string if the platform has none.
+func (route PlatformRoute) ToRegional() api.RegionalRoute {
+	switch route {
+	case BR1:
+		return api.AMERICAS
+	case EUN1:
+		return api.EUROPE
+	case EUW1:
+		return api.EUROPE
+	case JP1:
+		return api.ASIA
+	case KR:
+		return api.ASIA
+	case LA1:
+		return api.AMERICAS
+	case LA2:
+		return api.AMERICAS
+	case ME1:
+		return api.EUROPE
+	case NA1:
+		return api.AMERICAS
+	case OC1:
+		return api.SEA
+	case PBE1:
+		return api.AMERICAS
+	case PH2:
+		return api.SEA
+	case RU:
+		return api.EUROPE
+	case SG2:
+		return api.SEA
+	case TH2:
+		return api.SEA
+	case TR1:
+		return api.EUROPE
+	case TW2:
+		return api.SEA
+	case VN2:
+		return api.SEA
+	default:
+		return ""
+	}
+}
+
+// Returns the PlatformRoute matching the string, case-insensitive.
+func ParsePlatformRoute(route string) (PlatformRoute, error) {
+	switch strings.ToLower(route) {
+	case "br1":
+		return BR1, nil
+	case "eun1":
+		return EUN1, nil
+	case "euw1":
+		return EUW1, nil
+	case "jp1":
+		return JP1, nil
+	case "kr":
+		return KR, nil
+	case "la1":
+		return LA1, nil
+	case "la2":
+		return LA2, nil
+	case "me1":
+		return ME1, nil
+	case "na1":
+		return NA1, nil
+	case "oc1":
+		return OC1, nil
+	case "pbe1":
+		return PBE1, nil
+	case "ph2":
+		return PH2, nil
+	case "ru":
+		return RU, nil
+	case "sg2":
+		return SG2, nil
+	case "th2":
+		return TH2, nil
+	case "tr1":
+		return TR1, nil
+	case "tw2":
+		return TW2, nil
+	case "vn2":
+		return VN2, nil
+	default:
+		return "", fmt.Errorf("%w: unknown platform route '%s'", api.ErrInvalidRoute, route)
+	}
+}
+
+// Returns the PlatformRoute from the prefix of a match ID, such as 'EUW1' in 'EUW1_1234'.
+func PlatformRouteFromMatchID(matchID string) (PlatformRoute, error) {
+	prefix, _, ok := strings.Cut(matchID, "_")
+	if !ok {
+		return "", fmt.Errorf("%w: match ID '%s' has no platform prefix", api.ErrInvalidRoute, matchID)
+	}
+	return ParsePlatformRoute(prefix)
+}
+
 // Teamfight Tactics ranked tiers, such as gold, diamond, challenger, etc.
 type Tier string
 
@@ -0,0 +1,17 @@
+package tft_test
+
+import (
+	"testing"
+
+	"github.com/Kyagara/equinox/v2/api"
+	"github.com/Kyagara/equinox/v2/clients/tft"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoutes(t *testing.T) {
+	require.Equal(t, api.EUROPE, tft.EUW1.ToRegional())
+
+	platform, err := tft.PlatformRouteFromMatchID("KR_1234")
+	require.NoError(t, err)
+	require.Equal(t, tft.KR, platform)
+}
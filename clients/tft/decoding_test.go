@@ -0,0 +1,77 @@
+package tft_test
+
+///////////////////////////////////////////////
+//                                           //
+//                     !                     //
+//   This file is automatically generated!   //
+//           Do not directly edit!           //
+//                                           //
+///////////////////////////////////////////////
+
+// Spec version = c5f59a3e27f5101b78b8c7eb9b3fb88318b4225d
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"testing"
+
+	jsonv2 "github.com/go-json-experiment/json"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Kyagara/equinox/v2/clients/tft"
+	"github.com/Kyagara/equinox/v2/test/util"
+)
+
+// Decodes the sample response of every method, 'test/data/<method ID>.json', rejecting unknown members and
+// failing on fields missing from the sample, see util.MissingMembers.
+//
+// Methods without a sample response are skipped, at least one sample is required.
+func TestDecoding(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		MethodID string
+		Target   any
+	}{
+		{"tft-league-v1.getLeagueById", new(tft.LeagueListV1DTO)},
+		{"tft-league-v1.getChallengerLeague", new(tft.LeagueListV1DTO)},
+		{"tft-league-v1.getLeagueEntries", new([]tft.LeagueEntryV1DTO)},
+		{"tft-league-v1.getLeagueEntriesByPUUID", new([]tft.LeagueEntryV1DTO)},
+		{"tft-league-v1.getGrandmasterLeague", new(tft.LeagueListV1DTO)},
+		{"tft-league-v1.getMasterLeague", new(tft.LeagueListV1DTO)},
+		{"tft-league-v1.getTopRatedLadder", new([]tft.LeagueTopRatedLadderEntryV1DTO)},
+		{"tft-match-v1.getMatch", new(tft.MatchV1DTO)},
+		{"tft-match-v1.getMatchIdsByPUUID", new([]string)},
+		{"spectator-tft-v5.getCurrentGameInfoByPuuid", new(tft.SpectatorCurrentGameInfoV5DTO)},
+		{"spectator-tft-v5.getFeaturedGames", new(tft.SpectatorFeaturedGamesV5DTO)},
+		{"tft-status-v1.getPlatformData", new(tft.StatusPlatformDataV1DTO)},
+		{"tft-summoner-v1.getByAccessToken", new(tft.SummonerV1DTO)},
+		{"tft-summoner-v1.getByPUUID", new(tft.SummonerV1DTO)},
+	}
+
+	samples := 0
+	for _, test := range tests {
+		data, err := os.ReadFile("../../test/data/" + test.MethodID + ".json")
+		if err == nil {
+			samples++
+		}
+
+		t.Run(test.MethodID, func(t *testing.T) {
+			t.Parallel()
+
+			if errors.Is(err, fs.ErrNotExist) {
+				t.Skip("no sample response")
+			}
+			require.NoError(t, err)
+
+			require.NoError(t, jsonv2.Unmarshal(data, test.Target, jsonv2.RejectUnknownMembers(true)))
+
+			missing, err := util.MissingMembers(data, test.Target)
+			require.NoError(t, err)
+			require.Empty(t, missing, "fields missing from the sample response")
+		})
+	}
+
+	require.NotZero(t, samples, "no sample responses in 'test/data' for the tft client")
+}
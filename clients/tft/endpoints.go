@@ -56,6 +56,17 @@ func (endpoint *LeagueV1) ByID(ctx context.Context, route PlatformRoute, leagueI
 	return &data, nil
 }
 
+// Optional parameters for LeagueV1.ChallengerByQueueWithOptions, nil fields are omitted.
+type LeagueV1ChallengerByQueueOptions struct {
+	// Defaults to RANKED_TFT.
+	Queue *string
+}
+
+// Returns an error wrapping api.ErrInvalidParameter if a parameter is outside of its documented range.
+func (opts *LeagueV1ChallengerByQueueOptions) Validate() error {
+	return nil
+}
+
 // Get the challenger league.
 //
 // # Parameters
@@ -66,8 +77,37 @@ func (endpoint *LeagueV1) ByID(ctx context.Context, route PlatformRoute, leagueI
 //
 // [tft-league-v1.getChallengerLeague]
 //
+// Deprecated: Use ChallengerByQueueWithOptions instead, -1 or an empty string omits an optional parameter.
+//
 // [tft-league-v1.getChallengerLeague]: https://developer.riotgames.com/api-methods/#tft-league-v1/GET_getChallengerLeague
 func (endpoint *LeagueV1) ChallengerByQueue(ctx context.Context, route PlatformRoute, queue string) (*LeagueListV1DTO, error) {
+	opts := &LeagueV1ChallengerByQueueOptions{}
+	if queue != "" {
+		opts.Queue = &queue
+	}
+	return endpoint.challengerByQueue(ctx, route, opts)
+}
+
+// Get the challenger league.
+//
+// # Parameters
+//   - route: Route to query.
+//   - opts (optional): Optional parameters, nil omits all of them.
+//
+// # Riot API Reference
+//
+// [tft-league-v1.getChallengerLeague]
+//
+// [tft-league-v1.getChallengerLeague]: https://developer.riotgames.com/api-methods/#tft-league-v1/GET_getChallengerLeague
+func (endpoint *LeagueV1) ChallengerByQueueWithOptions(ctx context.Context, route PlatformRoute, opts *LeagueV1ChallengerByQueueOptions) (*LeagueListV1DTO, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	return endpoint.challengerByQueue(ctx, route, opts)
+}
+
+// Sends LeagueV1.ChallengerByQueueWithOptions without validating opts.
+func (endpoint *LeagueV1) challengerByQueue(ctx context.Context, route PlatformRoute, opts *LeagueV1ChallengerByQueueOptions) (*LeagueListV1DTO, error) {
 	logger := endpoint.internal.Logger("TFT_LeagueV1_ChallengerByQueue")
 	urlComponents := []string{"https://", route.String(), api.RIOT_API_BASE_URL_FORMAT, "/tft/league/v1/challenger"}
 	request, err := endpoint.internal.Request(ctx, logger, http.MethodGet, urlComponents, "tft-league-v1.getChallengerLeague", nil)
@@ -75,8 +115,10 @@ func (endpoint *LeagueV1) ChallengerByQueue(ctx context.Context, route PlatformR
 		return nil, err
 	}
 	values := url.Values{}
-	if queue != "" {
-		values.Set("queue", queue)
+	if opts != nil {
+		if opts.Queue != nil {
+			values.Set("queue", *opts.Queue)
+		}
 	}
 	request.Request.URL.RawQuery = values.Encode()
 	var data LeagueListV1DTO
@@ -87,6 +129,19 @@ func (endpoint *LeagueV1) ChallengerByQueue(ctx context.Context, route PlatformR
 	return &data, nil
 }
 
+// Optional parameters for LeagueV1.EntriesWithOptions, nil fields are omitted.
+type LeagueV1EntriesOptions struct {
+	// Defaults to RANKED_TFT.
+	Queue *string
+	// Defaults to 1. Starts with page 1.
+	Page *int
+}
+
+// Returns an error wrapping api.ErrInvalidParameter if a parameter is outside of its documented range.
+func (opts *LeagueV1EntriesOptions) Validate() error {
+	return nil
+}
+
 // Get all the league entries.
 //
 // # Parameters
@@ -100,8 +155,42 @@ func (endpoint *LeagueV1) ChallengerByQueue(ctx context.Context, route PlatformR
 //
 // [tft-league-v1.getLeagueEntries]
 //
+// Deprecated: Use EntriesWithOptions instead, -1 or an empty string omits an optional parameter.
+//
 // [tft-league-v1.getLeagueEntries]: https://developer.riotgames.com/api-methods/#tft-league-v1/GET_getLeagueEntries
 func (endpoint *LeagueV1) Entries(ctx context.Context, route PlatformRoute, tier Tier, division string, queue string, page int) ([]LeagueEntryV1DTO, error) {
+	opts := &LeagueV1EntriesOptions{}
+	if queue != "" {
+		opts.Queue = &queue
+	}
+	if page != -1 {
+		opts.Page = &page
+	}
+	return endpoint.entries(ctx, route, tier, division, opts)
+}
+
+// Get all the league entries.
+//
+// # Parameters
+//   - route: Route to query.
+//   - tier
+//   - division
+//   - opts (optional): Optional parameters, nil omits all of them.
+//
+// # Riot API Reference
+//
+// [tft-league-v1.getLeagueEntries]
+//
+// [tft-league-v1.getLeagueEntries]: https://developer.riotgames.com/api-methods/#tft-league-v1/GET_getLeagueEntries
+func (endpoint *LeagueV1) EntriesWithOptions(ctx context.Context, route PlatformRoute, tier Tier, division string, opts *LeagueV1EntriesOptions) ([]LeagueEntryV1DTO, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	return endpoint.entries(ctx, route, tier, division, opts)
+}
+
+// Sends LeagueV1.EntriesWithOptions without validating opts.
+func (endpoint *LeagueV1) entries(ctx context.Context, route PlatformRoute, tier Tier, division string, opts *LeagueV1EntriesOptions) ([]LeagueEntryV1DTO, error) {
 	logger := endpoint.internal.Logger("TFT_LeagueV1_Entries")
 	urlComponents := []string{"https://", route.String(), api.RIOT_API_BASE_URL_FORMAT, "/tft/league/v1/entries/", tier.String(), "/", division}
 	request, err := endpoint.internal.Request(ctx, logger, http.MethodGet, urlComponents, "tft-league-v1.getLeagueEntries", nil)
@@ -109,11 +198,13 @@ func (endpoint *LeagueV1) Entries(ctx context.Context, route PlatformRoute, tier
 		return nil, err
 	}
 	values := url.Values{}
-	if page != -1 {
-		values.Set("page", strconv.FormatInt(int64(page), 10))
-	}
-	if queue != "" {
-		values.Set("queue", queue)
+	if opts != nil {
+		if opts.Page != nil {
+			values.Set("page", strconv.FormatInt(int64(*opts.Page), 10))
+		}
+		if opts.Queue != nil {
+			values.Set("queue", *opts.Queue)
+		}
 	}
 	request.Request.URL.RawQuery = values.Encode()
 	var data []LeagueEntryV1DTO
@@ -150,6 +241,17 @@ func (endpoint *LeagueV1) EntriesByPUUID(ctx context.Context, route PlatformRout
 	return data, nil
 }
 
+// Optional parameters for LeagueV1.GrandmasterByQueueWithOptions, nil fields are omitted.
+type LeagueV1GrandmasterByQueueOptions struct {
+	// Defaults to RANKED_TFT.
+	Queue *string
+}
+
+// Returns an error wrapping api.ErrInvalidParameter if a parameter is outside of its documented range.
+func (opts *LeagueV1GrandmasterByQueueOptions) Validate() error {
+	return nil
+}
+
 // Get the grandmaster league.
 //
 // # Parameters
@@ -160,8 +262,37 @@ func (endpoint *LeagueV1) EntriesByPUUID(ctx context.Context, route PlatformRout
 //
 // [tft-league-v1.getGrandmasterLeague]
 //
+// Deprecated: Use GrandmasterByQueueWithOptions instead, -1 or an empty string omits an optional parameter.
+//
 // [tft-league-v1.getGrandmasterLeague]: https://developer.riotgames.com/api-methods/#tft-league-v1/GET_getGrandmasterLeague
 func (endpoint *LeagueV1) GrandmasterByQueue(ctx context.Context, route PlatformRoute, queue string) (*LeagueListV1DTO, error) {
+	opts := &LeagueV1GrandmasterByQueueOptions{}
+	if queue != "" {
+		opts.Queue = &queue
+	}
+	return endpoint.grandmasterByQueue(ctx, route, opts)
+}
+
+// Get the grandmaster league.
+//
+// # Parameters
+//   - route: Route to query.
+//   - opts (optional): Optional parameters, nil omits all of them.
+//
+// # Riot API Reference
+//
+// [tft-league-v1.getGrandmasterLeague]
+//
+// [tft-league-v1.getGrandmasterLeague]: https://developer.riotgames.com/api-methods/#tft-league-v1/GET_getGrandmasterLeague
+func (endpoint *LeagueV1) GrandmasterByQueueWithOptions(ctx context.Context, route PlatformRoute, opts *LeagueV1GrandmasterByQueueOptions) (*LeagueListV1DTO, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	return endpoint.grandmasterByQueue(ctx, route, opts)
+}
+
+// Sends LeagueV1.GrandmasterByQueueWithOptions without validating opts.
+func (endpoint *LeagueV1) grandmasterByQueue(ctx context.Context, route PlatformRoute, opts *LeagueV1GrandmasterByQueueOptions) (*LeagueListV1DTO, error) {
 	logger := endpoint.internal.Logger("TFT_LeagueV1_GrandmasterByQueue")
 	urlComponents := []string{"https://", route.String(), api.RIOT_API_BASE_URL_FORMAT, "/tft/league/v1/grandmaster"}
 	request, err := endpoint.internal.Request(ctx, logger, http.MethodGet, urlComponents, "tft-league-v1.getGrandmasterLeague", nil)
@@ -169,8 +300,10 @@ func (endpoint *LeagueV1) GrandmasterByQueue(ctx context.Context, route Platform
 		return nil, err
 	}
 	values := url.Values{}
-	if queue != "" {
-		values.Set("queue", queue)
+	if opts != nil {
+		if opts.Queue != nil {
+			values.Set("queue", *opts.Queue)
+		}
 	}
 	request.Request.URL.RawQuery = values.Encode()
 	var data LeagueListV1DTO
@@ -181,6 +314,17 @@ func (endpoint *LeagueV1) GrandmasterByQueue(ctx context.Context, route Platform
 	return &data, nil
 }
 
+// Optional parameters for LeagueV1.MasterByQueueWithOptions, nil fields are omitted.
+type LeagueV1MasterByQueueOptions struct {
+	// Defaults to RANKED_TFT.
+	Queue *string
+}
+
+// Returns an error wrapping api.ErrInvalidParameter if a parameter is outside of its documented range.
+func (opts *LeagueV1MasterByQueueOptions) Validate() error {
+	return nil
+}
+
 // Get the master league.
 //
 // # Parameters
@@ -191,8 +335,37 @@ func (endpoint *LeagueV1) GrandmasterByQueue(ctx context.Context, route Platform
 //
 // [tft-league-v1.getMasterLeague]
 //
+// Deprecated: Use MasterByQueueWithOptions instead, -1 or an empty string omits an optional parameter.
+//
 // [tft-league-v1.getMasterLeague]: https://developer.riotgames.com/api-methods/#tft-league-v1/GET_getMasterLeague
 func (endpoint *LeagueV1) MasterByQueue(ctx context.Context, route PlatformRoute, queue string) (*LeagueListV1DTO, error) {
+	opts := &LeagueV1MasterByQueueOptions{}
+	if queue != "" {
+		opts.Queue = &queue
+	}
+	return endpoint.masterByQueue(ctx, route, opts)
+}
+
+// Get the master league.
+//
+// # Parameters
+//   - route: Route to query.
+//   - opts (optional): Optional parameters, nil omits all of them.
+//
+// # Riot API Reference
+//
+// [tft-league-v1.getMasterLeague]
+//
+// [tft-league-v1.getMasterLeague]: https://developer.riotgames.com/api-methods/#tft-league-v1/GET_getMasterLeague
+func (endpoint *LeagueV1) MasterByQueueWithOptions(ctx context.Context, route PlatformRoute, opts *LeagueV1MasterByQueueOptions) (*LeagueListV1DTO, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	return endpoint.masterByQueue(ctx, route, opts)
+}
+
+// Sends LeagueV1.MasterByQueueWithOptions without validating opts.
+func (endpoint *LeagueV1) masterByQueue(ctx context.Context, route PlatformRoute, opts *LeagueV1MasterByQueueOptions) (*LeagueListV1DTO, error) {
 	logger := endpoint.internal.Logger("TFT_LeagueV1_MasterByQueue")
 	urlComponents := []string{"https://", route.String(), api.RIOT_API_BASE_URL_FORMAT, "/tft/league/v1/master"}
 	request, err := endpoint.internal.Request(ctx, logger, http.MethodGet, urlComponents, "tft-league-v1.getMasterLeague", nil)
@@ -200,8 +373,10 @@ func (endpoint *LeagueV1) MasterByQueue(ctx context.Context, route PlatformRoute
 		return nil, err
 	}
 	values := url.Values{}
-	if queue != "" {
-		values.Set("queue", queue)
+	if opts != nil {
+		if opts.Queue != nil {
+			values.Set("queue", *opts.Queue)
+		}
 	}
 	request.Request.URL.RawQuery = values.Encode()
 	var data LeagueListV1DTO
@@ -273,6 +448,23 @@ func (endpoint *MatchV1) ByID(ctx context.Context, route api.RegionalRoute, matc
 	return &data, nil
 }
 
+// Optional parameters for MatchV1.ListByPUUIDWithOptions, nil fields are omitted.
+type MatchV1ListByPUUIDOptions struct {
+	// Defaults to 0. Start index.
+	Start *int
+	// Epoch timestamp in seconds.
+	EndTime *int
+	// Epoch timestamp in seconds. The matchlist started storing timestamps on June 16th, 2021. Any matches played before June 16th, 2021 won't be included in the results if the startTime filter is set.
+	StartTime *int
+	// Defaults to 20. Number of match ids to return.
+	Count *int
+}
+
+// Returns an error wrapping api.ErrInvalidParameter if a parameter is outside of its documented range.
+func (opts *MatchV1ListByPUUIDOptions) Validate() error {
+	return nil
+}
+
 // Get a list of match ids by PUUID
 //
 // # Parameters
@@ -287,8 +479,47 @@ func (endpoint *MatchV1) ByID(ctx context.Context, route api.RegionalRoute, matc
 //
 // [tft-match-v1.getMatchIdsByPUUID]
 //
+// Deprecated: Use ListByPUUIDWithOptions instead, -1 or an empty string omits an optional parameter.
+//
 // [tft-match-v1.getMatchIdsByPUUID]: https://developer.riotgames.com/api-methods/#tft-match-v1/GET_getMatchIdsByPUUID
 func (endpoint *MatchV1) ListByPUUID(ctx context.Context, route api.RegionalRoute, puuid string, start int, endTime int, startTime int, count int) ([]string, error) {
+	opts := &MatchV1ListByPUUIDOptions{}
+	if start != -1 {
+		opts.Start = &start
+	}
+	if endTime != -1 {
+		opts.EndTime = &endTime
+	}
+	if startTime != -1 {
+		opts.StartTime = &startTime
+	}
+	if count != -1 {
+		opts.Count = &count
+	}
+	return endpoint.listByPUUID(ctx, route, puuid, opts)
+}
+
+// Get a list of match ids by PUUID
+//
+// # Parameters
+//   - route: Route to query.
+//   - puuid
+//   - opts (optional): Optional parameters, nil omits all of them.
+//
+// # Riot API Reference
+//
+// [tft-match-v1.getMatchIdsByPUUID]
+//
+// [tft-match-v1.getMatchIdsByPUUID]: https://developer.riotgames.com/api-methods/#tft-match-v1/GET_getMatchIdsByPUUID
+func (endpoint *MatchV1) ListByPUUIDWithOptions(ctx context.Context, route api.RegionalRoute, puuid string, opts *MatchV1ListByPUUIDOptions) ([]string, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	return endpoint.listByPUUID(ctx, route, puuid, opts)
+}
+
+// Sends MatchV1.ListByPUUIDWithOptions without validating opts.
+func (endpoint *MatchV1) listByPUUID(ctx context.Context, route api.RegionalRoute, puuid string, opts *MatchV1ListByPUUIDOptions) ([]string, error) {
 	logger := endpoint.internal.Logger("TFT_MatchV1_ListByPUUID")
 	urlComponents := []string{"https://", route.String(), api.RIOT_API_BASE_URL_FORMAT, "/tft/match/v1/matches/by-puuid/", puuid, "/ids"}
 	request, err := endpoint.internal.Request(ctx, logger, http.MethodGet, urlComponents, "tft-match-v1.getMatchIdsByPUUID", nil)
@@ -296,17 +527,19 @@ func (endpoint *MatchV1) ListByPUUID(ctx context.Context, route api.RegionalRout
 		return nil, err
 	}
 	values := url.Values{}
-	if count != -1 {
-		values.Set("count", strconv.FormatInt(int64(count), 10))
-	}
-	if endTime != -1 {
-		values.Set("endTime", strconv.FormatInt(int64(endTime), 10))
-	}
-	if start != -1 {
-		values.Set("start", strconv.FormatInt(int64(start), 10))
-	}
-	if startTime != -1 {
-		values.Set("startTime", strconv.FormatInt(int64(startTime), 10))
+	if opts != nil {
+		if opts.Count != nil {
+			values.Set("count", strconv.FormatInt(int64(*opts.Count), 10))
+		}
+		if opts.EndTime != nil {
+			values.Set("endTime", strconv.FormatInt(int64(*opts.EndTime), 10))
+		}
+		if opts.Start != nil {
+			values.Set("start", strconv.FormatInt(int64(*opts.Start), 10))
+		}
+		if opts.StartTime != nil {
+			values.Set("startTime", strconv.FormatInt(int64(*opts.StartTime), 10))
+		}
 	}
 	request.Request.URL.RawQuery = values.Encode()
 	data := make([]string, 0, 20)
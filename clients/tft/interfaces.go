@@ -0,0 +1,61 @@
+package tft
+
+///////////////////////////////////////////////
+//                                           //
+//                     !                     //
+//   This file is automatically generated!   //
+//           Do not directly edit!           //
+//                                           //
+///////////////////////////////////////////////
+
+// Spec version = c5f59a3e27f5101b78b8c7eb9b3fb88318b4225d
+
+import (
+	"context"
+
+	"github.com/Kyagara/equinox/v2/api"
+)
+
+// Methods of LeagueV1, implemented by *LeagueV1 and equinoxtest.TFTLeagueV1.
+type LeagueV1API interface {
+	ByID(ctx context.Context, route PlatformRoute, leagueId string) (*LeagueListV1DTO, error)
+	// Deprecated: Use ChallengerByQueueWithOptions instead.
+	ChallengerByQueue(ctx context.Context, route PlatformRoute, queue string) (*LeagueListV1DTO, error)
+	ChallengerByQueueWithOptions(ctx context.Context, route PlatformRoute, opts *LeagueV1ChallengerByQueueOptions) (*LeagueListV1DTO, error)
+	// Deprecated: Use EntriesWithOptions instead.
+	Entries(ctx context.Context, route PlatformRoute, tier Tier, division string, queue string, page int) ([]LeagueEntryV1DTO, error)
+	EntriesWithOptions(ctx context.Context, route PlatformRoute, tier Tier, division string, opts *LeagueV1EntriesOptions) ([]LeagueEntryV1DTO, error)
+	EntriesByPUUID(ctx context.Context, route PlatformRoute, puuid string) ([]LeagueEntryV1DTO, error)
+	// Deprecated: Use GrandmasterByQueueWithOptions instead.
+	GrandmasterByQueue(ctx context.Context, route PlatformRoute, queue string) (*LeagueListV1DTO, error)
+	GrandmasterByQueueWithOptions(ctx context.Context, route PlatformRoute, opts *LeagueV1GrandmasterByQueueOptions) (*LeagueListV1DTO, error)
+	// Deprecated: Use MasterByQueueWithOptions instead.
+	MasterByQueue(ctx context.Context, route PlatformRoute, queue string) (*LeagueListV1DTO, error)
+	MasterByQueueWithOptions(ctx context.Context, route PlatformRoute, opts *LeagueV1MasterByQueueOptions) (*LeagueListV1DTO, error)
+	TopRatedLadder(ctx context.Context, route PlatformRoute, queue QueueType) ([]LeagueTopRatedLadderEntryV1DTO, error)
+}
+
+// Methods of MatchV1, implemented by *MatchV1 and equinoxtest.TFTMatchV1.
+type MatchV1API interface {
+	ByID(ctx context.Context, route api.RegionalRoute, matchId string) (*MatchV1DTO, error)
+	// Deprecated: Use ListByPUUIDWithOptions instead.
+	ListByPUUID(ctx context.Context, route api.RegionalRoute, puuid string, start int, endTime int, startTime int, count int) ([]string, error)
+	ListByPUUIDWithOptions(ctx context.Context, route api.RegionalRoute, puuid string, opts *MatchV1ListByPUUIDOptions) ([]string, error)
+}
+
+// Methods of SpectatorV5, implemented by *SpectatorV5 and equinoxtest.TFTSpectatorV5.
+type SpectatorV5API interface {
+	CurrentGameInfoByPUUID(ctx context.Context, route PlatformRoute, encryptedPUUID string) (*SpectatorCurrentGameInfoV5DTO, error)
+	Featured(ctx context.Context, route PlatformRoute) (*SpectatorFeaturedGamesV5DTO, error)
+}
+
+// Methods of StatusV1, implemented by *StatusV1 and equinoxtest.TFTStatusV1.
+type StatusV1API interface {
+	Platform(ctx context.Context, route PlatformRoute) (*StatusPlatformDataV1DTO, error)
+}
+
+// Methods of SummonerV1, implemented by *SummonerV1 and equinoxtest.TFTSummonerV1.
+type SummonerV1API interface {
+	ByAccessToken(ctx context.Context, route PlatformRoute, accessToken string) (*SummonerV1DTO, error)
+	ByPUUID(ctx context.Context, route PlatformRoute, encryptedPUUID string) (*SummonerV1DTO, error)
+}
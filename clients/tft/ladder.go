@@ -0,0 +1,88 @@
+package tft
+
+import (
+	"context"
+	"iter"
+
+	"github.com/Kyagara/equinox/v2/api"
+	"github.com/Kyagara/equinox/v2/internal"
+)
+
+type ladderJob = internal.LadderJob[Tier, Division]
+
+var (
+	apexTiers = []Tier{CHALLENGER, GRANDMASTER, MASTER}
+	// Tiers with divisions, from highest to lowest.
+	divisionTiers = []Tier{DIAMOND, EMERALD, PLATINUM, GOLD, SILVER, BRONZE, IRON}
+	divisions     = []Division{I, II, III, IV}
+)
+
+// Returns an iterator over every entry of a queue's ladder in the platform, from CHALLENGER down to IRON IV.
+//
+// Apex tiers are fetched with ChallengerByQueue, GrandmasterByQueue and MasterByQueue and converted to LeagueEntryV1DTO,
+// divisions are paged with Entries until an empty page, every page is yielded as it arrives. Iteration stops after the first error.
+//
+// A tier/division only starts when the rate limiter has a request left for Entries, see api.LadderOptions.
+func (endpoint *LeagueV1) Ladder(ctx context.Context, route PlatformRoute, queue QueueType, opts *api.LadderOptions) iter.Seq2[LeagueEntryV1DTO, error] {
+	jobs := internal.LadderJobs(apexTiers, "", divisionTiers, divisions)
+	budget := endpoint.internal.RequestBudget(route.String(), "tft-league-v1.getLeagueEntries")
+
+	queueStr := queue.String()
+	return internal.FanOut(ctx, budget, jobs, internal.LadderConcurrency(opts), func(ctx context.Context, job ladderJob, send func([]LeagueEntryV1DTO) bool) error {
+		if job.Division == "" {
+			entries, err := endpoint.apexEntries(ctx, route, queue, job.Tier)
+			if err != nil {
+				return err
+			}
+			send(entries)
+			return nil
+		}
+
+		for page := 1; ; page++ {
+			res, err := endpoint.EntriesWithOptions(ctx, route, job.Tier, job.Division.String(), &LeagueV1EntriesOptions{Queue: &queueStr, Page: &page})
+			if err != nil {
+				return err
+			}
+			if len(res) == 0 || !send(res) {
+				return nil
+			}
+		}
+	})
+}
+
+func (endpoint *LeagueV1) apexEntries(ctx context.Context, route PlatformRoute, queue QueueType, tier Tier) ([]LeagueEntryV1DTO, error) {
+	queueStr := queue.String()
+	var list *LeagueListV1DTO
+	var err error
+	switch tier {
+	case CHALLENGER:
+		list, err = endpoint.ChallengerByQueueWithOptions(ctx, route, &LeagueV1ChallengerByQueueOptions{Queue: &queueStr})
+	case GRANDMASTER:
+		list, err = endpoint.GrandmasterByQueueWithOptions(ctx, route, &LeagueV1GrandmasterByQueueOptions{Queue: &queueStr})
+	default:
+		list, err = endpoint.MasterByQueueWithOptions(ctx, route, &LeagueV1MasterByQueueOptions{Queue: &queueStr})
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]LeagueEntryV1DTO, 0, len(list.Entries))
+	for _, item := range list.Entries {
+		entries = append(entries, LeagueEntryV1DTO{
+			LeagueID:     list.LeagueID,
+			PUUID:        item.PUUID,
+			QueueType:    queue,
+			Rank:         item.Rank,
+			Tier:         tier,
+			MiniSeries:   item.MiniSeries,
+			LeaguePoints: item.LeaguePoints,
+			Losses:       item.Losses,
+			Wins:         item.Wins,
+			FreshBlood:   item.FreshBlood,
+			HotStreak:    item.HotStreak,
+			Inactive:     item.Inactive,
+			Veteran:      item.Veteran,
+		})
+	}
+	return entries, nil
+}
@@ -0,0 +1,49 @@
+package tft_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Kyagara/equinox/v2"
+	"github.com/Kyagara/equinox/v2/api"
+	"github.com/Kyagara/equinox/v2/clients/tft"
+	"github.com/Kyagara/equinox/v2/test/util"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLeagueLadder(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterNoResponder(httpmock.NewStringResponder(200, `[]`))
+	httpmock.RegisterResponderWithQuery("GET", "https://br1.api.riotgames.com/tft/league/v1/challenger", "queue=RANKED_TFT",
+		httpmock.NewStringResponder(200, `{"leagueId":"challenger","tier":"CHALLENGER","entries":[{"puuid":"a","rank":"I","leaguePoints":1000}]}`))
+	httpmock.RegisterResponderWithQuery("GET", "https://br1.api.riotgames.com/tft/league/v1/grandmaster", "queue=RANKED_TFT",
+		httpmock.NewStringResponder(200, `{"leagueId":"grandmaster","tier":"GRANDMASTER","entries":[]}`))
+	httpmock.RegisterResponderWithQuery("GET", "https://br1.api.riotgames.com/tft/league/v1/master", "queue=RANKED_TFT",
+		httpmock.NewStringResponder(200, `{"leagueId":"master","tier":"MASTER","entries":[{"puuid":"b","rank":"I","leaguePoints":10}]}`))
+	httpmock.RegisterResponderWithQuery("GET", "https://br1.api.riotgames.com/tft/league/v1/entries/DIAMOND/I", "page=1&queue=RANKED_TFT",
+		httpmock.NewStringResponder(200, `[{"puuid":"c","tier":"DIAMOND","rank":"I","queueType":"RANKED_TFT"}]`))
+
+	config := util.NewTestEquinoxConfig()
+	client, err := equinox.NewCustomClient(config, nil, nil, nil)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	entries := []tft.LeagueEntryV1DTO{}
+	for entry, err := range client.TFT.LeagueV1.Ladder(ctx, tft.BR1, tft.RANKED_TFT_QUEUETYPE, &api.LadderOptions{Concurrency: 4}) {
+		require.NoError(t, err)
+		entries = append(entries, entry)
+	}
+	require.Len(t, entries, 3)
+	require.Equal(t, tft.LeagueEntryV1DTO{LeagueID: "challenger", PUUID: "a", QueueType: tft.RANKED_TFT_QUEUETYPE, Rank: tft.I, Tier: tft.CHALLENGER, LeaguePoints: 1000}, entries[0])
+	require.Equal(t, "b", entries[1].PUUID)
+	require.Equal(t, tft.MASTER, entries[1].Tier)
+	require.Equal(t, "c", entries[2].PUUID)
+	require.Equal(t, tft.DIAMOND, entries[2].Tier)
+
+	// 3 apex lists, 28 divisions and the second page of DIAMOND I
+	require.Equal(t, 3+28+1, httpmock.GetTotalCallCount())
+}
@@ -0,0 +1,28 @@
+package tft
+
+import (
+	"context"
+	"iter"
+
+	"github.com/Kyagara/equinox/v2/api"
+	"github.com/Kyagara/equinox/v2/internal"
+)
+
+// Number of match ids requested per page by MatchV1.AllIDsByPUUID when opts.Count is not set.
+const MATCH_IDS_PAGE_SIZE = 100
+
+// Returns an iterator over all match ids of a player, requesting pages of opts.Count (or MATCH_IDS_PAGE_SIZE) ids until a page comes back short.
+//
+// opts.Start sets the first index, time windows are sent with every page. Iteration stops after the first error, including context cancellation.
+func (endpoint *MatchV1) AllIDsByPUUID(ctx context.Context, route api.RegionalRoute, puuid string, opts *MatchV1ListByPUUIDOptions) iter.Seq2[string, error] {
+	var page MatchV1ListByPUUIDOptions
+	if opts != nil {
+		page = *opts
+	}
+	start, count := internal.PageBounds(page.Start, page.Count, MATCH_IDS_PAGE_SIZE)
+	return internal.Paginate(ctx, start, count, func(ctx context.Context, start int, count int) ([]string, error) {
+		page := page
+		page.Start, page.Count = &start, &count
+		return endpoint.ListByPUUIDWithOptions(ctx, route, puuid, &page)
+	})
+}
@@ -41,3 +41,37 @@ func NewVALClient(client *internal.Client) *Client {
 		StatusV1:        StatusV1{internal: client},
 	}
 }
+
+// Endpoint groups of the VAL Client, implemented by *Client and equinoxtest.VAL.
+type ClientAPI interface {
+	ConsoleMatchV1API() ConsoleMatchV1API
+	ConsoleRankedV1API() ConsoleRankedV1API
+	ContentV1API() ContentV1API
+	MatchV1API() MatchV1API
+	RankedV1API() RankedV1API
+	StatusV1API() StatusV1API
+}
+
+func (c *Client) ConsoleMatchV1API() ConsoleMatchV1API {
+	return &c.ConsoleMatchV1
+}
+
+func (c *Client) ConsoleRankedV1API() ConsoleRankedV1API {
+	return &c.ConsoleRankedV1
+}
+
+func (c *Client) ContentV1API() ContentV1API {
+	return &c.ContentV1
+}
+
+func (c *Client) MatchV1API() MatchV1API {
+	return &c.MatchV1
+}
+
+func (c *Client) RankedV1API() RankedV1API {
+	return &c.RankedV1
+}
+
+func (c *Client) StatusV1API() StatusV1API {
+	return &c.StatusV1
+}
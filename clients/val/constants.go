@@ -1,5 +1,12 @@
 package val
 
+import (
+	"fmt"
+	"strings"
+
+	"github.com/Kyagara/equinox/v2/api"
+)
+
 ///////////////////////////////////////////////
 //                                           //
 //                     !                     //
@@ -50,3 +57,45 @@ func (route PlatformRoute) String() string {
 		return string(route)
 	}
 }
+
+// Returns the regional route of this platform route, or an empty string if the platform has none.
+func (route PlatformRoute) ToRegional() api.RegionalRoute {
+	switch route {
+	case AP:
+		return api.ASIA
+	case BR:
+		return api.AMERICAS
+	case EU:
+		return api.EUROPE
+	case KR:
+		return api.ASIA
+	case LATAM:
+		return api.AMERICAS
+	case NA:
+		return api.AMERICAS
+	default:
+		return ""
+	}
+}
+
+// Returns the PlatformRoute matching the string, case-insensitive.
+func ParsePlatformRoute(route string) (PlatformRoute, error) {
+	switch strings.ToLower(route) {
+	case "ap":
+		return AP, nil
+	case "br":
+		return BR, nil
+	case "esports":
+		return ESPORTS, nil
+	case "eu":
+		return EU, nil
+	case "kr":
+		return KR, nil
+	case "latam":
+		return LATAM, nil
+	case "na":
+		return NA, nil
+	default:
+		return "", fmt.Errorf("%w: unknown platform route '%s'", api.ErrInvalidRoute, route)
+	}
+}
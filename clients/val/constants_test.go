@@ -0,0 +1,13 @@
+package val_test
+
+import (
+	"testing"
+
+	"github.com/Kyagara/equinox/v2/api"
+	"github.com/Kyagara/equinox/v2/clients/val"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRoutes(t *testing.T) {
+	require.Equal(t, api.AMERICAS, val.NA.ToRegional())
+}
@@ -12,6 +12,7 @@ package val
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -126,6 +127,25 @@ type ConsoleRankedV1 struct {
 	internal *internal.Client
 }
 
+// Optional parameters for ConsoleRankedV1.LeaderboardWithOptions, nil fields are omitted.
+type ConsoleRankedV1LeaderboardOptions struct {
+	// Defaults to 0.
+	StartIndex *int
+	// Defaults to 200. Valid values: 1 to 200.
+	Size *int
+}
+
+// Returns an error wrapping api.ErrInvalidParameter if a parameter is outside of its documented range.
+func (opts *ConsoleRankedV1LeaderboardOptions) Validate() error {
+	if opts == nil {
+		return nil
+	}
+	if opts.Size != nil && (*opts.Size < 1 || *opts.Size > 200) {
+		return fmt.Errorf("%w: size must be between 1 and 200, got %d", api.ErrInvalidParameter, *opts.Size)
+	}
+	return nil
+}
+
 // Get leaderboard for the competitive queue
 //
 // # Parameters
@@ -139,8 +159,42 @@ type ConsoleRankedV1 struct {
 //
 // [val-console-ranked-v1.getLeaderboard]
 //
+// Deprecated: Use LeaderboardWithOptions instead, -1 or an empty string omits an optional parameter.
+//
 // [val-console-ranked-v1.getLeaderboard]: https://developer.riotgames.com/api-methods/#val-console-ranked-v1/GET_getLeaderboard
 func (endpoint *ConsoleRankedV1) Leaderboard(ctx context.Context, route PlatformRoute, actId string, platformType string, startIndex int, size int) (*ConsoleRankedLeaderboardV1DTO, error) {
+	opts := &ConsoleRankedV1LeaderboardOptions{}
+	if startIndex != -1 {
+		opts.StartIndex = &startIndex
+	}
+	if size != -1 {
+		opts.Size = &size
+	}
+	return endpoint.leaderboard(ctx, route, actId, platformType, opts)
+}
+
+// Get leaderboard for the competitive queue
+//
+// # Parameters
+//   - route: Route to query.
+//   - actId: Act ids can be found using the val-content API.
+//   - platformType
+//   - opts (optional): Optional parameters, nil omits all of them.
+//
+// # Riot API Reference
+//
+// [val-console-ranked-v1.getLeaderboard]
+//
+// [val-console-ranked-v1.getLeaderboard]: https://developer.riotgames.com/api-methods/#val-console-ranked-v1/GET_getLeaderboard
+func (endpoint *ConsoleRankedV1) LeaderboardWithOptions(ctx context.Context, route PlatformRoute, actId string, platformType string, opts *ConsoleRankedV1LeaderboardOptions) (*ConsoleRankedLeaderboardV1DTO, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	return endpoint.leaderboard(ctx, route, actId, platformType, opts)
+}
+
+// Sends ConsoleRankedV1.LeaderboardWithOptions without validating opts.
+func (endpoint *ConsoleRankedV1) leaderboard(ctx context.Context, route PlatformRoute, actId string, platformType string, opts *ConsoleRankedV1LeaderboardOptions) (*ConsoleRankedLeaderboardV1DTO, error) {
 	logger := endpoint.internal.Logger("VAL_ConsoleRankedV1_Leaderboard")
 	urlComponents := []string{"https://", route.String(), api.RIOT_API_BASE_URL_FORMAT, "/val/console/ranked/v1/leaderboards/by-act/", actId}
 	request, err := endpoint.internal.Request(ctx, logger, http.MethodGet, urlComponents, "val-console-ranked-v1.getLeaderboard", nil)
@@ -151,11 +205,13 @@ func (endpoint *ConsoleRankedV1) Leaderboard(ctx context.Context, route Platform
 	if platformType != "" {
 		values.Set("platformType", platformType)
 	}
-	if size != -1 {
-		values.Set("size", strconv.FormatInt(int64(size), 10))
-	}
-	if startIndex != -1 {
-		values.Set("startIndex", strconv.FormatInt(int64(startIndex), 10))
+	if opts != nil {
+		if opts.Size != nil {
+			values.Set("size", strconv.FormatInt(int64(*opts.Size), 10))
+		}
+		if opts.StartIndex != nil {
+			values.Set("startIndex", strconv.FormatInt(int64(*opts.StartIndex), 10))
+		}
 	}
 	request.Request.URL.RawQuery = values.Encode()
 	var data ConsoleRankedLeaderboardV1DTO
@@ -175,6 +231,16 @@ type ContentV1 struct {
 	internal *internal.Client
 }
 
+// Optional parameters for ContentV1.ContentWithOptions, nil fields are omitted.
+type ContentV1ContentOptions struct {
+	Locale *string
+}
+
+// Returns an error wrapping api.ErrInvalidParameter if a parameter is outside of its documented range.
+func (opts *ContentV1ContentOptions) Validate() error {
+	return nil
+}
+
 // Get content optionally filtered by locale
 //
 // # Parameters
@@ -185,8 +251,37 @@ type ContentV1 struct {
 //
 // [val-content-v1.getContent]
 //
+// Deprecated: Use ContentWithOptions instead, -1 or an empty string omits an optional parameter.
+//
 // [val-content-v1.getContent]: https://developer.riotgames.com/api-methods/#val-content-v1/GET_getContent
 func (endpoint *ContentV1) Content(ctx context.Context, route PlatformRoute, locale string) (*ContentV1DTO, error) {
+	opts := &ContentV1ContentOptions{}
+	if locale != "" {
+		opts.Locale = &locale
+	}
+	return endpoint.content(ctx, route, opts)
+}
+
+// Get content optionally filtered by locale
+//
+// # Parameters
+//   - route: Route to query.
+//   - opts (optional): Optional parameters, nil omits all of them.
+//
+// # Riot API Reference
+//
+// [val-content-v1.getContent]
+//
+// [val-content-v1.getContent]: https://developer.riotgames.com/api-methods/#val-content-v1/GET_getContent
+func (endpoint *ContentV1) ContentWithOptions(ctx context.Context, route PlatformRoute, opts *ContentV1ContentOptions) (*ContentV1DTO, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	return endpoint.content(ctx, route, opts)
+}
+
+// Sends ContentV1.ContentWithOptions without validating opts.
+func (endpoint *ContentV1) content(ctx context.Context, route PlatformRoute, opts *ContentV1ContentOptions) (*ContentV1DTO, error) {
 	logger := endpoint.internal.Logger("VAL_ContentV1_Content")
 	urlComponents := []string{"https://", route.String(), api.RIOT_API_BASE_URL_FORMAT, "/val/content/v1/contents"}
 	request, err := endpoint.internal.Request(ctx, logger, http.MethodGet, urlComponents, "val-content-v1.getContent", nil)
@@ -194,8 +289,10 @@ func (endpoint *ContentV1) Content(ctx context.Context, route PlatformRoute, loc
 		return nil, err
 	}
 	values := url.Values{}
-	if locale != "" {
-		values.Set("locale", locale)
+	if opts != nil {
+		if opts.Locale != nil {
+			values.Set("locale", *opts.Locale)
+		}
 	}
 	request.Request.URL.RawQuery = values.Encode()
 	var data ContentV1DTO
@@ -306,6 +403,25 @@ type RankedV1 struct {
 	internal *internal.Client
 }
 
+// Optional parameters for RankedV1.LeaderboardWithOptions, nil fields are omitted.
+type RankedV1LeaderboardOptions struct {
+	// Defaults to 200. Valid values: 1 to 200.
+	Size *int
+	// Defaults to 0.
+	StartIndex *int
+}
+
+// Returns an error wrapping api.ErrInvalidParameter if a parameter is outside of its documented range.
+func (opts *RankedV1LeaderboardOptions) Validate() error {
+	if opts == nil {
+		return nil
+	}
+	if opts.Size != nil && (*opts.Size < 1 || *opts.Size > 200) {
+		return fmt.Errorf("%w: size must be between 1 and 200, got %d", api.ErrInvalidParameter, *opts.Size)
+	}
+	return nil
+}
+
 // Get leaderboard for the competitive queue
 //
 // # Parameters
@@ -318,8 +434,41 @@ type RankedV1 struct {
 //
 // [val-ranked-v1.getLeaderboard]
 //
+// Deprecated: Use LeaderboardWithOptions instead, -1 or an empty string omits an optional parameter.
+//
 // [val-ranked-v1.getLeaderboard]: https://developer.riotgames.com/api-methods/#val-ranked-v1/GET_getLeaderboard
 func (endpoint *RankedV1) Leaderboard(ctx context.Context, route PlatformRoute, actId string, size int, startIndex int) (*RankedLeaderboardV1DTO, error) {
+	opts := &RankedV1LeaderboardOptions{}
+	if size != -1 {
+		opts.Size = &size
+	}
+	if startIndex != -1 {
+		opts.StartIndex = &startIndex
+	}
+	return endpoint.leaderboard(ctx, route, actId, opts)
+}
+
+// Get leaderboard for the competitive queue
+//
+// # Parameters
+//   - route: Route to query.
+//   - actId: Act ids can be found using the val-content API.
+//   - opts (optional): Optional parameters, nil omits all of them.
+//
+// # Riot API Reference
+//
+// [val-ranked-v1.getLeaderboard]
+//
+// [val-ranked-v1.getLeaderboard]: https://developer.riotgames.com/api-methods/#val-ranked-v1/GET_getLeaderboard
+func (endpoint *RankedV1) LeaderboardWithOptions(ctx context.Context, route PlatformRoute, actId string, opts *RankedV1LeaderboardOptions) (*RankedLeaderboardV1DTO, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+	return endpoint.leaderboard(ctx, route, actId, opts)
+}
+
+// Sends RankedV1.LeaderboardWithOptions without validating opts.
+func (endpoint *RankedV1) leaderboard(ctx context.Context, route PlatformRoute, actId string, opts *RankedV1LeaderboardOptions) (*RankedLeaderboardV1DTO, error) {
 	logger := endpoint.internal.Logger("VAL_RankedV1_Leaderboard")
 	urlComponents := []string{"https://", route.String(), api.RIOT_API_BASE_URL_FORMAT, "/val/ranked/v1/leaderboards/by-act/", actId}
 	request, err := endpoint.internal.Request(ctx, logger, http.MethodGet, urlComponents, "val-ranked-v1.getLeaderboard", nil)
@@ -327,11 +476,13 @@ func (endpoint *RankedV1) Leaderboard(ctx context.Context, route PlatformRoute,
 		return nil, err
 	}
 	values := url.Values{}
-	if size != -1 {
-		values.Set("size", strconv.FormatInt(int64(size), 10))
-	}
-	if startIndex != -1 {
-		values.Set("startIndex", strconv.FormatInt(int64(startIndex), 10))
+	if opts != nil {
+		if opts.Size != nil {
+			values.Set("size", strconv.FormatInt(int64(*opts.Size), 10))
+		}
+		if opts.StartIndex != nil {
+			values.Set("startIndex", strconv.FormatInt(int64(*opts.StartIndex), 10))
+		}
 	}
 	request.Request.URL.RawQuery = values.Encode()
 	var data RankedLeaderboardV1DTO
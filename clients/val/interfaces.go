@@ -0,0 +1,53 @@
+package val
+
+///////////////////////////////////////////////
+//                                           //
+//                     !                     //
+//   This file is automatically generated!   //
+//           Do not directly edit!           //
+//                                           //
+///////////////////////////////////////////////
+
+// Spec version = c5f59a3e27f5101b78b8c7eb9b3fb88318b4225d
+
+import "context"
+
+// Methods of ConsoleMatchV1, implemented by *ConsoleMatchV1 and equinoxtest.VALConsoleMatchV1.
+type ConsoleMatchV1API interface {
+	ByID(ctx context.Context, route PlatformRoute, matchId string) (*ConsoleMatchMatchV1DTO, error)
+	ListByPUUID(ctx context.Context, route PlatformRoute, puuid string, platformType string) (*ConsoleMatchMatchlistV1DTO, error)
+	Recent(ctx context.Context, route PlatformRoute, queue string) (*ConsoleMatchRecentMatchesV1DTO, error)
+}
+
+// Methods of ConsoleRankedV1, implemented by *ConsoleRankedV1 and equinoxtest.VALConsoleRankedV1.
+type ConsoleRankedV1API interface {
+	// Deprecated: Use LeaderboardWithOptions instead.
+	Leaderboard(ctx context.Context, route PlatformRoute, actId string, platformType string, startIndex int, size int) (*ConsoleRankedLeaderboardV1DTO, error)
+	LeaderboardWithOptions(ctx context.Context, route PlatformRoute, actId string, platformType string, opts *ConsoleRankedV1LeaderboardOptions) (*ConsoleRankedLeaderboardV1DTO, error)
+}
+
+// Methods of ContentV1, implemented by *ContentV1 and equinoxtest.VALContentV1.
+type ContentV1API interface {
+	// Deprecated: Use ContentWithOptions instead.
+	Content(ctx context.Context, route PlatformRoute, locale string) (*ContentV1DTO, error)
+	ContentWithOptions(ctx context.Context, route PlatformRoute, opts *ContentV1ContentOptions) (*ContentV1DTO, error)
+}
+
+// Methods of MatchV1, implemented by *MatchV1 and equinoxtest.VALMatchV1.
+type MatchV1API interface {
+	ByID(ctx context.Context, route PlatformRoute, matchId string) (*MatchV1DTO, error)
+	ListByPUUID(ctx context.Context, route PlatformRoute, puuid string) (*MatchlistV1DTO, error)
+	Recent(ctx context.Context, route PlatformRoute, queue string) (*MatchRecentMatchesV1DTO, error)
+}
+
+// Methods of RankedV1, implemented by *RankedV1 and equinoxtest.VALRankedV1.
+type RankedV1API interface {
+	// Deprecated: Use LeaderboardWithOptions instead.
+	Leaderboard(ctx context.Context, route PlatformRoute, actId string, size int, startIndex int) (*RankedLeaderboardV1DTO, error)
+	LeaderboardWithOptions(ctx context.Context, route PlatformRoute, actId string, opts *RankedV1LeaderboardOptions) (*RankedLeaderboardV1DTO, error)
+}
+
+// Methods of StatusV1, implemented by *StatusV1 and equinoxtest.VALStatusV1.
+type StatusV1API interface {
+	Platform(ctx context.Context, route PlatformRoute) (*StatusPlatformDataV1DTO, error)
+}
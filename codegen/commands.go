@@ -118,6 +118,7 @@ func compileApi(specs map[string]gjson.Result, specVersion string) error {
 
 	ctx := pongo2.Context{
 		"Preamble":       preamble,
+		"SpecVersion":    specVersion,
 		"RegionalRoutes": regionalRoutes,
 		"Endpoints":      endpoints,
 	}
@@ -164,6 +165,7 @@ func compileClients(specs map[string]gjson.Result, specVersion string) error {
 
 		schemas := specs["spec"].Get("components.schemas")
 
+		testPreamble := preamble(clientName+"_test", specVersion)
 		preamble := preamble(clientName, specVersion)
 		normalizedClientName := getNormalizedClientName(clientName)
 
@@ -174,6 +176,7 @@ func compileClients(specs map[string]gjson.Result, specVersion string) error {
 		gameTypes := getGenericConstants(specs["gameTypes"], "GameType")
 		gameModes := getGenericConstants(specs["gameModes"], "GameMode")
 		queueTypes := getGenericConstants(specs["queueTypes"], "QueueType")
+		eventTypes := getEventTypeConstants()
 
 		endpointGroups := getEndpointGroup(clientName, specs["spec"])
 		endpointGroupsKeys := getMapKeys(endpointGroups)
@@ -186,11 +189,14 @@ func compileClients(specs map[string]gjson.Result, specVersion string) error {
 			"Split":                strings.Split,
 			"FilterTFT":            filterTFT,
 			"Preamble":             preamble,
+			"TestPreamble":         testPreamble,
 			"ClientName":           clientName,
 			"NormalizedClientName": normalizedClientName,
 			"FormatEndpointName":   formatEndpointName,
 			"GetGameName":          getFullName,
 			"RemoveGameName":       removeGameName,
+			"QualifyType":          qualifyType,
+			"QualifyArguments":     qualifyArguments,
 
 			"VALRoutes":      valRoutes,
 			"LOL_TFT_Routes": LOL_TFT_Routes,
@@ -199,6 +205,7 @@ func compileClients(specs map[string]gjson.Result, specVersion string) error {
 			"GameTypes":      gameTypes,
 			"GameModes":      gameModes,
 			"QueueTypes":     queueTypes,
+			"EventTypes":     eventTypes,
 
 			"EndpointGroups":        endpointGroups,
 			"EndpointGroupKeys":     endpointGroupsKeys,
@@ -242,6 +249,40 @@ func compileClients(specs map[string]gjson.Result, specVersion string) error {
 				return fmt.Errorf("error writing to file: %w", err)
 			}
 		}
+
+		err = compileFakes(ctx, clientName, specVersion)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Generates the fakes of a client in the equinoxtest package, one file per client.
+func compileFakes(ctx pongo2.Context, clientName string, specVersion string) error {
+	ctx["Preamble"] = preamble("equinoxtest", specVersion)
+
+	templates, err := readTemplateFiles("./templates/equinoxtest")
+	if err != nil {
+		return err
+	}
+
+	for _, template := range templates {
+		tmpl, err := pongo2.FromBytes(template)
+		if err != nil {
+			return err
+		}
+
+		result, err := tmpl.ExecuteBytes(ctx)
+		if err != nil {
+			return err
+		}
+
+		err = os.WriteFile("../equinoxtest/"+clientName+".go", result, 0644)
+		if err != nil {
+			return fmt.Errorf("error writing to file: %w", err)
+		}
 	}
 
 	return nil
@@ -17,8 +17,10 @@ type Endpoint struct {
 type RouteConstant struct {
 	Value            string
 	TournamentRegion string
-	Description      string
-	Deprecated       bool
+	// Name of the api.RegionalRoute constant for this platform, empty if not present in the routes table.
+	RegionalRoute string
+	Description   string
+	Deprecated    bool
 }
 
 type GenericConstant struct {
@@ -36,11 +38,13 @@ func getRouteConstants(routesTable gjson.Result, routeType string) map[string]Ro
 		name = strings.ToUpper(name)
 		description := normalizeDescription(details.Get("description").String())
 		tournamentRegion := details.Get("tournamentRegion").String()
+		regionalRoute := strings.ToUpper(details.Get("regionalRoute").String())
 		deprecated := details.Get("deprecated").Bool()
 
 		routes[name] = RouteConstant{
 			Value:            value,
 			TournamentRegion: tournamentRegion,
+			RegionalRoute:    regionalRoute,
 			Description:      description,
 			Deprecated:       deprecated,
 		}
@@ -105,3 +109,43 @@ func getAllEndpoints(paths gjson.Result) []Endpoint {
 
 	return endpoints
 }
+
+// Types of the events in a match-v5 timeline, not available in the specs.
+var timelineEventTypes = map[string]string{
+	"ASCENDED_EVENT":            "Ascension game mode event.",
+	"BUILDING_KILL":             "A turret or inhibitor was destroyed.",
+	"CAPTURE_POINT":             "Dominion capture point event.",
+	"CHAMPION_KILL":             "A champion was killed.",
+	"CHAMPION_SPECIAL_KILL":     "First blood, multi kill or ace.",
+	"CHAMPION_TRANSFORM":        "Kayn transformed.",
+	"DRAGON_SOUL_GIVEN":         "A team received a dragon soul.",
+	"ELITE_MONSTER_KILL":        "A dragon, Baron Nashor, Rift Herald, Void Grub or Atakhan was killed.",
+	"FEAT_UPDATE":               "Progress in a Feat of Warfare.",
+	"GAME_END":                  "The game ended.",
+	"ITEM_DESTROYED":            "An item was consumed or destroyed.",
+	"ITEM_PURCHASED":            "An item was purchased.",
+	"ITEM_SOLD":                 "An item was sold.",
+	"ITEM_UNDO":                 "An item purchase or sale was undone.",
+	"LEVEL_UP":                  "A champion leveled up.",
+	"OBJECTIVE_BOUNTY_FINISH":   "An objective bounty ended.",
+	"OBJECTIVE_BOUNTY_PRESTART": "An objective bounty is about to start.",
+	"PAUSE_END":                 "The game was unpaused, the first event of a timeline.",
+	"PAUSE_START":               "The game was paused.",
+	"SKILL_LEVEL_UP":            "A champion leveled up an ability.",
+	"TURRET_PLATE_DESTROYED":    "A turret plate was destroyed.",
+	"WARD_KILL":                 "A ward was destroyed.",
+	"WARD_PLACED":               "A ward was placed.",
+}
+
+func getEventTypeConstants() map[string]GenericConstant {
+	consts := make(map[string]GenericConstant, len(timelineEventTypes))
+
+	for value, description := range timelineEventTypes {
+		consts[value+"_EVENTTYPE"] = GenericConstant{
+			Value:       value,
+			Description: description,
+		}
+	}
+
+	return consts
+}
@@ -0,0 +1,403 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/tidwall/gjson"
+)
+
+const (
+	// Where the specs are copied to before being updated, used as the old specs by 'diff'.
+	PREVIOUS_SPECS_DIR = "./specs/previous"
+	SPECS_DIR          = "./specs"
+)
+
+// Spec files compared by 'diff', the constants tables are optional.
+var diffedSpecs = []string{"spec.json", "routesTable.json", "maps.json", "queues.json", "queueTypes.json", "gameTypes.json", "gameModes.json"}
+
+// Name used for the constants of each table, same as in Compile.
+var constantTables = [][]string{
+	{"maps.json", "Map"},
+	{"queues.json", "Queue"},
+	{"queueTypes.json", "QueueType"},
+	{"gameTypes.json", "GameType"},
+	{"gameModes.json", "GameMode"},
+}
+
+type ChangeAction string
+
+const (
+	Added      ChangeAction = "+"
+	Removed    ChangeAction = "-"
+	Changed    ChangeAction = "~"
+	Deprecated ChangeAction = "!"
+)
+
+type Change struct {
+	Action ChangeAction
+	// 'endpoint', 'parameter', 'object', 'field', 'enum', 'route' or 'constant'.
+	Kind string
+	// Such as 'match-v5.getMatch', 'match-v5.getMatch.count' or 'match-v5.MatchDto.info'.
+	Name   string
+	Detail string
+	// Set if code using the generated clients could stop compiling or decoding.
+	Breaking bool
+}
+
+func (c Change) String() string {
+	line := fmt.Sprintf("%s %-9s %s", c.Action, c.Kind, c.Name)
+	if c.Detail != "" {
+		line += ": " + c.Detail
+	}
+	if c.Breaking {
+		line += " (breaking)"
+	}
+	return line
+}
+
+// Copies the current specs to PREVIOUS_SPECS_DIR, does nothing if there are no specs yet.
+func SavePreviousSpecs() error {
+	for _, filename := range diffedSpecs {
+		data, err := os.ReadFile(filepath.Join(SPECS_DIR, filename))
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		err = os.MkdirAll(PREVIOUS_SPECS_DIR, os.ModePerm)
+		if err != nil {
+			return fmt.Errorf("error creating directory: %w", err)
+		}
+
+		err = os.WriteFile(filepath.Join(PREVIOUS_SPECS_DIR, filename), data, 0644)
+		if err != nil {
+			return fmt.Errorf("error writing to file: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// Compares the specs in two directories, printing the changes. Returns the number of breaking changes.
+func Diff(w io.Writer, oldDir string, newDir string) (int, error) {
+	oldSpecs, err := readSpecs(oldDir)
+	if err != nil {
+		return 0, err
+	}
+
+	newSpecs, err := readSpecs(newDir)
+	if err != nil {
+		return 0, err
+	}
+
+	oldSpec, newSpec := oldSpecs["spec.json"], newSpecs["spec.json"]
+	fmt.Fprintf(w, "Spec version: %s -> %s\n", oldSpec.Get("info.version"), newSpec.Get("info.version"))
+
+	changes := diffEndpoints(oldSpec.Get("paths"), newSpec.Get("paths"))
+	changes = append(changes, diffSchemas(oldSpec.Get("components.schemas"), newSpec.Get("components.schemas"))...)
+	changes = append(changes, diffRoutes(oldSpecs["routesTable.json"], newSpecs["routesTable.json"])...)
+	for _, table := range constantTables {
+		changes = append(changes, diffConstants(oldSpecs[table[0]], newSpecs[table[0]], table[1])...)
+	}
+
+	breaking := 0
+	for _, change := range changes {
+		fmt.Fprintln(w, change)
+		if change.Breaking {
+			breaking++
+		}
+	}
+
+	fmt.Fprintf(w, "%d changes, %d breaking\n", len(changes), breaking)
+	return breaking, nil
+}
+
+func readSpecs(dir string) (map[string]gjson.Result, error) {
+	specs := make(map[string]gjson.Result, len(diffedSpecs))
+	for _, filename := range diffedSpecs {
+		data, err := os.ReadFile(filepath.Join(dir, filename))
+		if os.IsNotExist(err) && filename != "spec.json" && filename != "routesTable.json" {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if !gjson.ValidBytes(data) {
+			return nil, fmt.Errorf("invalid JSON in '%s'", filepath.Join(dir, filename))
+		}
+		specs[filename] = gjson.ParseBytes(data)
+	}
+	return specs, nil
+}
+
+// Returns the operations in the paths keyed by their operation ID.
+func getOperations(paths gjson.Result) map[string]gjson.Result {
+	operations := make(map[string]gjson.Result)
+	for path, groups := range paths.Map() {
+		for verb, operation := range groups.Map() {
+			if strings.HasPrefix(verb, "x-") {
+				continue
+			}
+			// Keeping the path and verb with the operation
+			raw := fmt.Sprintf(`{"path":%q,"verb":%q,"operation":%s}`, path, strings.ToUpper(verb), operation.Raw)
+			operations[operation.Get("operationId").String()] = gjson.Parse(raw)
+		}
+	}
+	return operations
+}
+
+func diffEndpoints(oldPaths gjson.Result, newPaths gjson.Result) []Change {
+	oldOperations, newOperations := getOperations(oldPaths), getOperations(newPaths)
+	changes := make([]Change, 0)
+
+	for _, id := range sortedKeys(oldOperations, newOperations) {
+		old, inOld := oldOperations[id]
+		new, inNew := newOperations[id]
+		route := new.Get("verb").String() + " " + new.Get("path").String()
+
+		switch {
+		case !inNew:
+			changes = append(changes, Change{Action: Removed, Kind: "endpoint", Name: id, Detail: old.Get("verb").String() + " " + old.Get("path").String(), Breaking: true})
+			continue
+		case !inOld:
+			changes = append(changes, Change{Action: Added, Kind: "endpoint", Name: id, Detail: route})
+			continue
+		}
+
+		oldRoute := old.Get("verb").String() + " " + old.Get("path").String()
+		if oldRoute != route {
+			changes = append(changes, Change{Action: Changed, Kind: "endpoint", Name: id, Detail: fmt.Sprintf("'%s' -> '%s'", oldRoute, route)})
+		}
+
+		oldReturn, newReturn := getResponseType(old.Get("operation")), getResponseType(new.Get("operation"))
+		if oldReturn != newReturn {
+			changes = append(changes, Change{Action: Changed, Kind: "endpoint", Name: id, Detail: fmt.Sprintf("return type '%s' -> '%s'", oldReturn, newReturn), Breaking: true})
+		}
+
+		oldBody, newBody := stringifyType(old.Get("operation.requestBody.content.application/json.schema")), stringifyType(new.Get("operation.requestBody.content.application/json.schema"))
+		if oldBody != newBody {
+			changes = append(changes, Change{Action: Changed, Kind: "endpoint", Name: id, Detail: fmt.Sprintf("body type '%s' -> '%s'", oldBody, newBody), Breaking: true})
+		}
+
+		if !old.Get("operation.deprecated").Bool() && new.Get("operation.deprecated").Bool() {
+			changes = append(changes, Change{Action: Deprecated, Kind: "endpoint", Name: id})
+		}
+
+		changes = append(changes, diffParameters(id, old.Get("operation.parameters"), new.Get("operation.parameters"))...)
+	}
+
+	return changes
+}
+
+func getResponseType(operation gjson.Result) string {
+	return stringifyType(operation.Get("responses.200.content.application/json.schema"))
+}
+
+func diffParameters(operationID string, oldParams gjson.Result, newParams gjson.Result) []Change {
+	byName := func(params gjson.Result) map[string]gjson.Result {
+		m := make(map[string]gjson.Result)
+		for _, param := range params.Array() {
+			m[param.Get("name").String()] = param
+		}
+		return m
+	}
+
+	oldByName, newByName := byName(oldParams), byName(newParams)
+	changes := make([]Change, 0)
+
+	for _, name := range sortedKeys(oldByName, newByName) {
+		old, inOld := oldByName[name]
+		new, inNew := newByName[name]
+		fullName := operationID + "." + name
+
+		switch {
+		case !inNew:
+			changes = append(changes, Change{Action: Removed, Kind: "parameter", Name: fullName, Breaking: true})
+			continue
+		case !inOld:
+			required := new.Get("required").Bool()
+			changes = append(changes, Change{Action: Added, Kind: "parameter", Name: fullName, Detail: describeParam(new), Breaking: required})
+			continue
+		}
+
+		if describeParam(old) != describeParam(new) {
+			changes = append(changes, Change{Action: Changed, Kind: "parameter", Name: fullName, Detail: fmt.Sprintf("'%s' -> '%s'", describeParam(old), describeParam(new)), Breaking: true})
+		}
+
+		if !old.Get("deprecated").Bool() && new.Get("deprecated").Bool() {
+			changes = append(changes, Change{Action: Deprecated, Kind: "parameter", Name: fullName})
+		}
+
+		changes = append(changes, diffEnum(fullName, old.Get("schema"), new.Get("schema"))...)
+	}
+
+	return changes
+}
+
+// Returns the location, type and if the parameter is required, such as 'query int, optional'.
+func describeParam(param gjson.Result) string {
+	required := "optional"
+	if param.Get("required").Bool() {
+		required = "required"
+	}
+	return fmt.Sprintf("%s %s, %s", param.Get("in"), stringifyType(param.Get("schema")), required)
+}
+
+func diffSchemas(oldSchemas gjson.Result, newSchemas gjson.Result) []Change {
+	oldMap, newMap := oldSchemas.Map(), newSchemas.Map()
+	changes := make([]Change, 0)
+
+	for _, dto := range sortedKeys(oldMap, newMap) {
+		old, inOld := oldMap[dto]
+		new, inNew := newMap[dto]
+
+		switch {
+		case !inNew:
+			changes = append(changes, Change{Action: Removed, Kind: "object", Name: dto, Breaking: true})
+			continue
+		case !inOld:
+			changes = append(changes, Change{Action: Added, Kind: "object", Name: dto})
+			continue
+		}
+
+		oldProps, newProps := old.Get("properties").Map(), new.Get("properties").Map()
+		for _, prop := range sortedKeys(oldProps, newProps) {
+			oldProp, inOld := oldProps[prop]
+			newProp, inNew := newProps[prop]
+			fullName := dto + "." + prop
+
+			switch {
+			case !inNew:
+				changes = append(changes, Change{Action: Removed, Kind: "field", Name: fullName, Detail: stringifyType(oldProp), Breaking: true})
+				continue
+			case !inOld:
+				changes = append(changes, Change{Action: Added, Kind: "field", Name: fullName, Detail: stringifyType(newProp)})
+				continue
+			}
+
+			oldType, newType := stringifyType(oldProp), stringifyType(newProp)
+			if oldType != newType {
+				changes = append(changes, Change{Action: Changed, Kind: "field", Name: fullName, Detail: fmt.Sprintf("'%s' -> '%s'", oldType, newType), Breaking: true})
+			}
+
+			// Same check used to mark fields as deprecated in the models
+			if !isDeprecatedProp(oldProp) && isDeprecatedProp(newProp) {
+				changes = append(changes, Change{Action: Deprecated, Kind: "field", Name: fullName})
+			}
+
+			changes = append(changes, diffEnum(fullName, oldProp, newProp)...)
+		}
+	}
+
+	return changes
+}
+
+func isDeprecatedProp(prop gjson.Result) bool {
+	return prop.Get("deprecated").Bool() || strings.Contains(strings.ToLower(prop.Get("description").String()), "deprecated")
+}
+
+// Compares the 'enum' values of a schema, removing a value is breaking.
+func diffEnum(name string, oldSchema gjson.Result, newSchema gjson.Result) []Change {
+	values := func(schema gjson.Result) map[string]bool {
+		if schema.Get("items").Exists() {
+			schema = schema.Get("items")
+		}
+		m := make(map[string]bool)
+		for _, value := range schema.Get("enum").Array() {
+			m[value.String()] = true
+		}
+		return m
+	}
+
+	oldValues, newValues := values(oldSchema), values(newSchema)
+	changes := make([]Change, 0)
+
+	for _, value := range sortedKeys(oldValues, newValues) {
+		switch {
+		case !newValues[value]:
+			changes = append(changes, Change{Action: Removed, Kind: "enum", Name: name, Detail: value, Breaking: true})
+		case !oldValues[value]:
+			changes = append(changes, Change{Action: Added, Kind: "enum", Name: name, Detail: value})
+		}
+	}
+
+	return changes
+}
+
+// Compares the routes of each type in the routes table, such as 'platform' and 'regional'.
+func diffRoutes(oldTable gjson.Result, newTable gjson.Result) []Change {
+	oldTypes, newTypes := oldTable.Map(), newTable.Map()
+	changes := make([]Change, 0)
+
+	for _, routeType := range sortedKeys(oldTypes, newTypes) {
+		oldRoutes, newRoutes := oldTypes[routeType].Map(), newTypes[routeType].Map()
+
+		for _, route := range sortedKeys(oldRoutes, newRoutes) {
+			old, inOld := oldRoutes[route]
+			new, inNew := newRoutes[route]
+			fullName := routeType + "." + route
+
+			switch {
+			case !inNew:
+				changes = append(changes, Change{Action: Removed, Kind: "route", Name: fullName, Breaking: true})
+			case !inOld:
+				changes = append(changes, Change{Action: Added, Kind: "route", Name: fullName, Detail: new.Get("description").String()})
+			case !old.Get("deprecated").Bool() && new.Get("deprecated").Bool():
+				changes = append(changes, Change{Action: Deprecated, Kind: "route", Name: fullName})
+			}
+		}
+	}
+
+	return changes
+}
+
+// Compares the generated constants of a table, such as 'SUMMONERS_RIFT_MAP'.
+func diffConstants(oldTable gjson.Result, newTable gjson.Result, constName string) []Change {
+	// Missing in one of the directories, nothing to compare
+	if !oldTable.Exists() || !newTable.Exists() {
+		return nil
+	}
+
+	oldConsts, newConsts := getGenericConstants(oldTable, constName), getGenericConstants(newTable, constName)
+	changes := make([]Change, 0)
+
+	for _, name := range sortedKeys(oldConsts, newConsts) {
+		old, inOld := oldConsts[name]
+		new, inNew := newConsts[name]
+
+		switch {
+		case !inNew:
+			changes = append(changes, Change{Action: Removed, Kind: "constant", Name: name, Detail: old.Value, Breaking: true})
+		case !inOld:
+			changes = append(changes, Change{Action: Added, Kind: "constant", Name: name, Detail: new.Value})
+		case old.Value != new.Value:
+			changes = append(changes, Change{Action: Changed, Kind: "constant", Name: name, Detail: fmt.Sprintf("'%s' -> '%s'", old.Value, new.Value), Breaking: true})
+		case !old.Deprecated && new.Deprecated:
+			changes = append(changes, Change{Action: Deprecated, Kind: "constant", Name: name})
+		}
+	}
+
+	return changes
+}
+
+// Returns the sorted union of the keys of both maps.
+func sortedKeys[V any](old map[string]V, new map[string]V) []string {
+	keys := make([]string, 0, len(old)+len(new))
+	for key := range old {
+		keys = append(keys, key)
+	}
+	for key := range new {
+		if _, ok := old[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,174 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// Returns a spec.json with the paths and schemas provided.
+func testSpec(paths string, schemas string) string {
+	return `{"info":{"version":"1"},"paths":{` + paths + `},"components":{"schemas":{` + schemas + `}}}`
+}
+
+const (
+	testRoutes = `{"platform":{"br1":{"description":"Brazil"}},"regional":{"americas":{}}}`
+
+	testPath   = `"/lol/match/v5/matches/{matchId}":{"get":{"operationId":"match-v5.getMatch","parameters":[{"name":"matchId","in":"path","required":true,"schema":{"type":"string"}}],"responses":{"200":{"content":{"application/json":{"schema":{"$ref":"#/components/schemas/match-v5.MatchDto"}}}}}}}`
+	testSchema = `"match-v5.MatchDto":{"properties":{"gameId":{"type":"integer"},"queue":{"type":"string","enum":["SOLO","FLEX"]}}}`
+)
+
+func TestDiff(t *testing.T) {
+	tests := []struct {
+		name string
+		// Files replacing the base specs in the old and new directories.
+		old      map[string]string
+		new      map[string]string
+		want     []string
+		breaking int
+	}{
+		{
+			name: "no changes",
+		},
+		{
+			name:     "endpoint removed",
+			new:      map[string]string{"spec.json": testSpec("", testSchema)},
+			want:     []string{"- endpoint  match-v5.getMatch: GET /lol/match/v5/matches/{matchId} (breaking)"},
+			breaking: 1,
+		},
+		{
+			name: "endpoint added and deprecated",
+			old:  map[string]string{"spec.json": testSpec("", testSchema)},
+			new:  map[string]string{"spec.json": testSpec(strings.Replace(testPath, `"get":{`, `"get":{"deprecated":true,`, 1), testSchema)},
+			want: []string{"+ endpoint  match-v5.getMatch: GET /lol/match/v5/matches/{matchId}"},
+		},
+		{
+			name:     "return type changed",
+			new:      map[string]string{"spec.json": testSpec(strings.Replace(testPath, "match-v5.MatchDto", "match-v5.MatchV2Dto", 1), testSchema)},
+			want:     []string{"~ endpoint  match-v5.getMatch: return type 'MatchDto' -> 'MatchV2Dto' (breaking)"},
+			breaking: 1,
+		},
+		{
+			name: "parameters",
+			new: map[string]string{"spec.json": testSpec(strings.Replace(testPath, `"parameters":[`,
+				`"parameters":[{"name":"start","in":"query","schema":{"type":"integer"}},{"name":"count","in":"query","required":true,"schema":{"type":"integer"}},`, 1), testSchema)},
+			want: []string{
+				"+ parameter match-v5.getMatch.count: query int, required (breaking)",
+				"+ parameter match-v5.getMatch.start: query int, optional",
+			},
+			breaking: 1,
+		},
+		{
+			name:     "object removed",
+			new:      map[string]string{"spec.json": testSpec(testPath, "")},
+			want:     []string{"- object    match-v5.MatchDto (breaking)"},
+			breaking: 1,
+		},
+		{
+			name: "object added",
+			new:  map[string]string{"spec.json": testSpec(testPath, testSchema+`,"match-v5.TimelineDto":{"properties":{}}`)},
+			want: []string{"+ object    match-v5.TimelineDto"},
+		},
+		{
+			name: "fields",
+			new: map[string]string{"spec.json": testSpec(testPath,
+				`"match-v5.MatchDto":{"properties":{"gameId":{"type":"number"},"gameName":{"type":"string","description":"Deprecated."},"queue":{"type":"string","enum":["SOLO","ARAM"]}}}`)},
+			want: []string{
+				"~ field     match-v5.MatchDto.gameId: 'int' -> 'float64' (breaking)",
+				"+ field     match-v5.MatchDto.gameName: string",
+				"+ enum      match-v5.MatchDto.queue: ARAM",
+				"- enum      match-v5.MatchDto.queue: FLEX (breaking)",
+			},
+			breaking: 2,
+		},
+		{
+			name: "field removed and deprecated",
+			new: map[string]string{"spec.json": testSpec(testPath,
+				`"match-v5.MatchDto":{"properties":{"gameId":{"type":"integer","deprecated":true}}}`)},
+			want: []string{
+				"! field     match-v5.MatchDto.gameId",
+				"- field     match-v5.MatchDto.queue: string (breaking)",
+			},
+			breaking: 1,
+		},
+		{
+			name: "routes",
+			new:  map[string]string{"routesTable.json": `{"platform":{"br1":{"deprecated":true},"la1":{"description":"Latin America North"}},"regional":{}}`},
+			want: []string{
+				"! route     platform.br1",
+				"+ route     platform.la1: Latin America North",
+				"- route     regional.americas (breaking)",
+			},
+			breaking: 1,
+		},
+		{
+			name: "constants",
+			old:  map[string]string{"maps.json": `[{"x-name":"summoners_rift","x-value":11},{"x-name":"howling_abyss","x-value":12}]`},
+			new:  map[string]string{"maps.json": `[{"x-name":"summoners_rift","x-value":12},{"x-name":"nexus_blitz","x-value":21}]`},
+			want: []string{
+				"- constant  HOWLING_ABYSS_MAP: 12 (breaking)",
+				"+ constant  NEXUS_BLITZ_MAP: 21",
+				"~ constant  SUMMONERS_RIFT_MAP: '11' -> '12' (breaking)",
+			},
+			breaking: 2,
+		},
+		{
+			name: "constants missing in the new specs",
+			old:  map[string]string{"maps.json": `[{"x-name":"summoners_rift","x-value":11}]`},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			oldDir, newDir := t.TempDir(), t.TempDir()
+			base := map[string]string{"spec.json": testSpec(testPath, testSchema), "routesTable.json": testRoutes}
+			writeSpecs(t, oldDir, base, test.old)
+			writeSpecs(t, newDir, base, test.new)
+
+			var output strings.Builder
+			breaking, err := Diff(&output, oldDir, newDir)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			lines := strings.Split(strings.TrimSpace(output.String()), "\n")
+			if lines[0] != "Spec version: 1 -> 1" {
+				t.Errorf("unexpected header '%s'", lines[0])
+			}
+			got := lines[1 : len(lines)-1]
+			if !slices.Equal(got, test.want) {
+				t.Errorf("changes:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(test.want, "\n"))
+			}
+			if breaking != test.breaking {
+				t.Errorf("breaking = %d, want %d", breaking, test.breaking)
+			}
+		})
+	}
+}
+
+func TestDiffMissingSpec(t *testing.T) {
+	_, err := Diff(&strings.Builder{}, t.TempDir(), t.TempDir())
+	if !os.IsNotExist(err) {
+		t.Errorf("err = %v, want a not exist error", err)
+	}
+}
+
+// Writes the base files, replaced by the ones in files.
+func writeSpecs(t *testing.T, dir string, base map[string]string, files map[string]string) {
+	t.Helper()
+	for name, data := range base {
+		if _, ok := files[name]; ok {
+			continue
+		}
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(data), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for name, data := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(data), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
@@ -73,8 +73,15 @@ type Methods struct {
 	Body              string
 	Description       []string
 	Queries           []string
-	HasReturn         bool
-	IsRSO             bool
+	// Set if the method has optional query parameters, a shim with the previous positional signature is also generated.
+	Options         *Options
+	ShimArguments   string
+	ShimDescription []string
+	CallArguments   string
+	// Unexported method sending the request, the shim calls it directly so its parameters are not validated.
+	SendName  string
+	HasReturn bool
+	IsRSO     bool
 }
 
 func getAPIEndpoints(endpointGroup map[string][]EndpointGroup) map[string][]Methods {
@@ -116,19 +123,26 @@ func getAPIEndpoints(endpointGroup map[string][]EndpointGroup) map[string][]Meth
 					"ctx context.Context",
 					"route " + normalizedRoute + "Route",
 				}
+				callBuilder := []string{"ctx", "route"}
 
 				if isRso {
 					argBuilder = append(argBuilder, "accessToken string")
+					callBuilder = append(callBuilder, "accessToken")
 				}
 
 				body := "nil"
 				if bodyType != "" {
 					argBuilder = append(argBuilder, "body *"+bodyType)
+					callBuilder = append(callBuilder, "body")
 					body = "body"
 				}
 
+				// Arguments used by the shim, optional query parameters are positional
+				shimArgBuilder := slices.Clone(argBuilder)
+
 				allParams := operation.Get("parameters")
 				var queryParams []gjson.Result
+				var optionalQueryParams []gjson.Result
 				var routeArgument string
 				if allParams.Exists() {
 					pathParams := getSortedParams(allParams, "path", route)
@@ -136,10 +150,17 @@ func getAPIEndpoints(endpointGroup map[string][]EndpointGroup) map[string][]Meth
 
 					for _, paramList := range [][]gjson.Result{pathParams, queryParams} {
 						for _, param := range paramList {
-							argBuilder = append(argBuilder,
-								normalizePropName(param.Get("name").String())+
-									" "+
-									stringifyType(param.Get("schema")))
+							name := normalizePropName(param.Get("name").String())
+							arg := name + " " + stringifyType(param.Get("schema"))
+							shimArgBuilder = append(shimArgBuilder, arg)
+
+							if param.Get("in").String() == "query" && !param.Get("required").Bool() {
+								optionalQueryParams = append(optionalQueryParams, param)
+								continue
+							}
+
+							argBuilder = append(argBuilder, arg)
+							callBuilder = append(callBuilder, name)
 						}
 					}
 
@@ -148,6 +169,16 @@ func getAPIEndpoints(endpointGroup map[string][]EndpointGroup) map[string][]Meth
 					routeArgument = formatRouteArgument([]gjson.Result{}, route)
 				}
 
+				var options *Options
+				if len(optionalQueryParams) > 0 {
+					options = getOptions(removeGameName(structName)+methodName+"Options", optionalQueryParams)
+					argBuilder = append(argBuilder, "opts *"+options.Name)
+					callBuilder = append(callBuilder, "opts")
+					queryParams = slices.DeleteFunc(queryParams, func(param gjson.Result) bool {
+						return !param.Get("required").Bool()
+					})
+				}
+
 				isPrimitiveType := returnType != "" && slices.Contains(goTypes, returnType)
 				nilValue := getNilValue(returnType)
 
@@ -172,6 +203,8 @@ func getAPIEndpoints(endpointGroup map[string][]EndpointGroup) map[string][]Meth
 					)
 				}
 
+				shimDescArr := slices.Clone(descArr)
+
 				if len(allParams.Array()) > 0 {
 					for _, param := range allParams.Array() {
 						requiredStr := ""
@@ -185,19 +218,37 @@ func getAPIEndpoints(endpointGroup map[string][]EndpointGroup) map[string][]Meth
 							requiredStr += ":"
 						}
 
-						descArr = append(descArr,
-							fmt.Sprintf("   - %s%s %s", param.Get("name").String(), requiredStr, desc),
-						)
+						line := fmt.Sprintf("   - %s%s %s", param.Get("name").String(), requiredStr, desc)
+						shimDescArr = append(shimDescArr, line)
+
+						if options != nil && param.Get("in").String() == "query" && !required {
+							continue
+						}
+
+						descArr = append(descArr, line)
 					}
 				}
 
-				descArr = append(descArr,
+				if options != nil {
+					descArr = append(descArr,
+						"   - opts (optional): Optional parameters, nil omits all of them.",
+					)
+				}
+
+				reference := []string{
 					"",
 					"# Riot API Reference",
 					"",
 					fmt.Sprintf("[%s]", operationID),
 					"",
 					fmt.Sprintf("[%s]: %s", operationID, operation.Get("externalDocs.url")),
+				}
+
+				descArr = append(descArr, reference...)
+				shimDescArr = append(shimDescArr, reference...)
+				shimDescArr = append(shimDescArr,
+					"",
+					fmt.Sprintf("Deprecated: Use %sWithOptions instead, -1 or an empty string omits an optional parameter.", methodName),
 				)
 
 				methodReturnTuple := "error"
@@ -234,6 +285,11 @@ func getAPIEndpoints(endpointGroup map[string][]EndpointGroup) map[string][]Meth
 					ErrorReturn:       errorReturn,
 					Prealloc:          preallocMapping[operationID],
 					Queries:           formatAddQueryParam(queryParams),
+					Options:           options,
+					ShimArguments:     strings.Join(shimArgBuilder, ", "),
+					ShimDescription:   shimDescArr,
+					CallArguments:     strings.Join(callBuilder, ", "),
+					SendName:          strings.ToLower(methodName[:1]) + methodName[1:],
 					IsRSO:             isRso,
 				})
 			}
@@ -23,6 +23,8 @@ var (
 	versionRegex = regexp.MustCompile(`v.*\d`)
 	clientRegex  = regexp.MustCompile("(?i)(lor|riot|val|lol|tft)")
 	mapTypeRegex = regexp.MustCompile(`\](.+)`)
+	// Exported identifiers not preceded by a package name.
+	localTypeRegex = regexp.MustCompile(`(^|[^.\w])([A-Z]\w*)`)
 )
 
 func preamble(packageName string, version string) string {
@@ -109,6 +111,21 @@ func getNormalizedClientName(clientName string) string {
 	}
 }
 
+// Prefixes the package-local types in a type expression with the package name, e.g. '(*MatchV5DTO, error)' becomes '(*lol.MatchV5DTO, error)'.
+func qualifyType(typeExpr string, packageName string) string {
+	return localTypeRegex.ReplaceAllString(typeExpr, "${1}"+packageName+".${2}")
+}
+
+// Same as qualifyType, for every type in a list of arguments such as 'ctx context.Context, route PlatformRoute'.
+func qualifyArguments(arguments string, packageName string) string {
+	args := strings.Split(arguments, ", ")
+	for i, arg := range args {
+		name, typeExpr, _ := strings.Cut(arg, " ")
+		args[i] = name + " " + qualifyType(typeExpr, packageName)
+	}
+	return strings.Join(args, ", ")
+}
+
 func formatEndpointName(endpointName string) string {
 	var name strings.Builder
 
@@ -48,7 +48,16 @@ func main() {
 		}
 	}
 
+	if flag.Arg(0) == "diff" {
+		os.Exit(runDiff(flag.Args()[1:]))
+	}
+
 	if *updateFlag || os.Getenv("UPDATE_SPECS") == "1" {
+		err := SavePreviousSpecs()
+		if err != nil {
+			panic(err)
+		}
+
 		fmt.Printf("Downloading specs...\n")
 		for _, spec := range SPECS_URLS {
 			err := DownloadAndSaveSpecs(spec)
@@ -56,6 +65,14 @@ func main() {
 				panic(err)
 			}
 		}
+
+		// Only possible if there were specs before updating
+		if _, err := os.Stat(PREVIOUS_SPECS_DIR + "/spec.json"); err == nil {
+			_, err := Diff(os.Stdout, PREVIOUS_SPECS_DIR, SPECS_DIR)
+			if err != nil {
+				panic(err)
+			}
+		}
 	}
 
 	err = Compile()
@@ -70,3 +87,23 @@ func main() {
 
 	fmt.Printf("Done\n")
 }
+
+// Runs the 'diff' command, returning the exit code.
+func runDiff(args []string) int {
+	diffFlags := flag.NewFlagSet("diff", flag.ExitOnError)
+	oldDir := diffFlags.String("old", PREVIOUS_SPECS_DIR, "Directory with the old specs.")
+	newDir := diffFlags.String("new", SPECS_DIR, "Directory with the new specs.")
+	failOnBreaking := diffFlags.Bool("fail-on-breaking", false, "Exit with status 1 if there are breaking changes.")
+	diffFlags.Parse(args)
+
+	breaking, err := Diff(os.Stdout, *oldDir, *newDir)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error comparing specs: %v\n", err)
+		return 2
+	}
+
+	if *failOnBreaking && breaking > 0 {
+		return 1
+	}
+	return 0
+}
@@ -22,6 +22,9 @@ type ModelProperty struct {
 	JSONField   string
 }
 
+// Data objects whose properties are capitalized in the responses but not in the spec, such as 'PlayerScore0' in match-v5.MissionsDto.
+var capitalizedProperties = []string{"match-v5.MissionsDto"}
+
 func getAPIModels(filteredEndpointGroups map[string][]string, schema map[string]gjson.Result) map[string]Model {
 	apiModels := make(map[string]Model, len(filteredEndpointGroups))
 	dtoCount := 0
@@ -76,11 +79,16 @@ func getAPIModels(filteredEndpointGroups map[string][]string, schema map[string]
 
 				namesUsed = append(namesUsed, name)
 
+				jsonKey := propKey
+				if slices.Contains(capitalizedProperties, rawDTO) {
+					jsonKey = strings.ToUpper(propKey[:1]) + propKey[1:]
+				}
+
 				props = append(props, ModelProperty{
 					Name:        name,
 					Type:        fieldType,
 					Description: description,
-					JSONField:   fmt.Sprintf("`json:\"%s,omitempty\"`", propKey),
+					JSONField:   fmt.Sprintf("`json:\"%s,omitempty\"`", jsonKey),
 				})
 			}
 
@@ -0,0 +1,119 @@
+package main
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/iancoleman/strcase"
+	"github.com/tidwall/gjson"
+)
+
+var (
+	rangeRegex = regexp.MustCompile(`Valid values: (\d+) to (\d+)`)
+	maxRegex   = regexp.MustCompile(`\(max (\d+)\)`)
+)
+
+// Options struct generated for methods with optional query parameters.
+type Options struct {
+	Name        string
+	Fields      []OptionField
+	Queries     []string
+	Conversions []string
+	Validations []string
+}
+
+type OptionField struct {
+	Name        string
+	Type        string
+	Description string
+}
+
+func getOptions(name string, params []gjson.Result) *Options {
+	options := &Options{
+		Name:        name,
+		Fields:      make([]OptionField, 0, len(params)),
+		Queries:     make([]string, 0, len(params)),
+		Conversions: make([]string, 0, len(params)),
+		Validations: make([]string, 0, 1),
+	}
+
+	for _, param := range params {
+		queryName := param.Get("name").String()
+		argName := normalizePropName(queryName)
+		fieldName := getOptionFieldName(queryName)
+		prop := param.Get("schema")
+		propType := prop.Get("type").String()
+		description := param.Get("description").String()
+
+		options.Fields = append(options.Fields, OptionField{
+			Name:        fieldName,
+			Type:        stringifyType(prop),
+			Description: normalizeDescription(description),
+		})
+
+		var value string
+		var condition string
+		switch {
+		case prop.Get("x-enum").Exists():
+			value = fmt.Sprintf("opts.%s.String()", fieldName)
+		case propType == "integer":
+			value = fmt.Sprintf("strconv.FormatInt(int64(*opts.%s), 10)", fieldName)
+		case propType == "string":
+			value = fmt.Sprintf("*opts.%s", fieldName)
+		default:
+			panic(fmt.Errorf("unknown prop type: %s", propType))
+		}
+
+		switch propType {
+		case "string":
+			condition = fmt.Sprintf(`%s != ""`, argName)
+		case "integer":
+			condition = fmt.Sprintf(`%s != -1`, argName)
+		}
+
+		options.Queries = append(options.Queries, fmt.Sprintf(`if opts.%s != nil {
+    values.Set("%s", %s)
+}`, fieldName, queryName, value))
+
+		options.Conversions = append(options.Conversions, fmt.Sprintf(`if %s {
+    opts.%s = &%s
+}`, condition, fieldName, argName))
+
+		validation := getOptionValidation(fieldName, queryName, description)
+		if validation != "" {
+			options.Validations = append(options.Validations, validation)
+		}
+	}
+
+	return options
+}
+
+func getOptionFieldName(queryName string) string {
+	name := strcase.ToCamel(queryName)
+	if strings.HasSuffix(name, "Id") {
+		name = name[:len(name)-2] + "ID"
+	}
+	return name
+}
+
+// Returns a check for the range documented in the parameter description, if any.
+func getOptionValidation(fieldName string, queryName string, description string) string {
+	if match := rangeRegex.FindStringSubmatch(description); match != nil {
+		minimum, _ := strconv.Atoi(match[1])
+		maximum, _ := strconv.Atoi(match[2])
+		return fmt.Sprintf(`if opts.%s != nil && (*opts.%s < %d || *opts.%s > %d) {
+    return fmt.Errorf("%%w: %s must be between %d and %d, got %%d", api.ErrInvalidParameter, *opts.%s)
+}`, fieldName, fieldName, minimum, fieldName, maximum, queryName, minimum, maximum, fieldName)
+	}
+
+	if match := maxRegex.FindStringSubmatch(description); match != nil {
+		maximum, _ := strconv.Atoi(match[1])
+		return fmt.Sprintf(`if opts.%s != nil && *opts.%s > %d {
+    return fmt.Errorf("%%w: %s must be at most %d, got %%d", api.ErrInvalidParameter, *opts.%s)
+}`, fieldName, fieldName, maximum, queryName, maximum, fieldName)
+	}
+
+	return ""
+}
@@ -9,6 +9,8 @@ import (
 	"github.com/Kyagara/equinox/v2/api"
 	"github.com/Kyagara/equinox/v2/cache"
 	"github.com/Kyagara/equinox/v2/clients/lol"
+	"github.com/Kyagara/equinox/v2/clients/val"
+	"github.com/Kyagara/equinox/v2/equinoxtest"
 	"github.com/Kyagara/equinox/v2/internal"
 	"github.com/Kyagara/equinox/v2/ratelimit"
 	"github.com/Kyagara/equinox/v2/test/util"
@@ -61,7 +63,7 @@ func TestClientMethods(t *testing.T) {
 
 	// Using match.list as an example since its a []string
 	httpmock.RegisterResponder("POST", "https://americas.api.riotgames.com/lol/tournament/v5/codes",
-		httpmock.NewJsonResponderOrPanic(200, httpmock.File("./test/data/match.list.json")).Once())
+		httpmock.NewJsonResponderOrPanic(200, httpmock.File("./test/data/match-v5.getMatchIdsByPUUID.json")).Once())
 
 	// Post with a body
 	ctx := context.Background()
@@ -78,6 +80,95 @@ func TestClientMethods(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestEndpointOptions(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponderWithQuery("GET", "https://americas.api.riotgames.com/lol/match/v5/matches/by-puuid/puuid/ids", "count=5&queue=420&start=10",
+		httpmock.NewStringResponder(200, `["BR1_1"]`))
+	httpmock.RegisterResponderWithQuery("GET", "https://americas.api.riotgames.com/lol/match/v5/matches/by-puuid/puuid/ids", "",
+		httpmock.NewStringResponder(200, `["BR1_2"]`))
+	httpmock.RegisterResponderWithQuery("GET", "https://americas.api.riotgames.com/lol/match/v5/matches/by-puuid/puuid/ids", "count=101",
+		httpmock.NewStringResponder(400, `{}`))
+
+	config := util.NewTestEquinoxConfig()
+	client, err := equinox.NewCustomClient(config, nil, nil, nil)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	// Invalid range, the request is never sent
+	count := 101
+	ids, err := client.LOL.MatchV5.ListByPUUIDWithOptions(ctx, api.AMERICAS, "puuid", &lol.MatchV5ListByPUUIDOptions{Count: &count})
+	require.ErrorIs(t, err, api.ErrInvalidParameter)
+	require.Nil(t, ids)
+	require.Equal(t, 0, httpmock.GetTotalCallCount())
+
+	// The shim is not validated, the request is sent as before
+	ids, err = client.LOL.MatchV5.ListByPUUID(ctx, api.AMERICAS, "puuid", -1, -1, -1, "", -1, 101)
+	require.ErrorIs(t, err, api.ErrBadRequest)
+	require.Nil(t, ids)
+	require.Equal(t, 1, httpmock.GetTotalCallCount())
+
+	count = 5
+	start := 10
+	queue := lol.SUMMONERS_RIFT_5V5_RANKED_SOLO_QUEUE
+	ids, err = client.LOL.MatchV5.ListByPUUIDWithOptions(ctx, api.AMERICAS, "puuid", &lol.MatchV5ListByPUUIDOptions{Queue: &queue, Start: &start, Count: &count})
+	require.NoError(t, err)
+	require.Equal(t, []string{"BR1_1"}, ids)
+
+	// Shim produces the same query
+	ids, err = client.LOL.MatchV5.ListByPUUID(ctx, api.AMERICAS, "puuid", -1, -1, queue, "", 10, 5)
+	require.NoError(t, err)
+	require.Equal(t, []string{"BR1_1"}, ids)
+
+	// nil omits all optional parameters
+	ids, err = client.LOL.MatchV5.ListByPUUIDWithOptions(ctx, api.AMERICAS, "puuid", nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{"BR1_2"}, ids)
+
+	ids, err = client.LOL.MatchV5.ListByPUUID(ctx, api.AMERICAS, "puuid", -1, -1, -1, "", -1, -1)
+	require.NoError(t, err)
+	require.Equal(t, []string{"BR1_2"}, ids)
+
+	require.Equal(t, 5, httpmock.GetTotalCallCount())
+}
+
+func TestEndpointOptionsRequests(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponderWithQuery("POST", "https://americas.api.riotgames.com/lol/tournament/v5/codes", "count=20&tournamentId=420",
+		httpmock.NewJsonResponderOrPanic(200, httpmock.File("./test/data/match-v5.getMatchIdsByPUUID.json")).Once())
+	// A zero value is sent, unlike a nil field
+	httpmock.RegisterResponderWithQuery("GET", "https://br.api.riotgames.com/val/ranked/v1/leaderboards/by-act/act", "size=2&startIndex=0",
+		httpmock.NewStringResponder(200, `{"shard":"br"}`).Once())
+	httpmock.RegisterResponderWithQuery("GET", "https://na.api.riotgames.com/val/content/v1/contents", "locale=en-US",
+		httpmock.NewStringResponder(200, `{"version":"release-01.00"}`).Once())
+
+	client, err := equinox.NewCustomClient(util.NewTestEquinoxConfig(), nil, nil, nil)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	count := 20
+	codes, err := client.LOL.TournamentV5.CreateTournamentCodeWithOptions(ctx, api.AMERICAS, &lol.TournamentCodeParametersV5DTO{MapType: "SUMMONERS_RIFT"}, 420, &lol.TournamentV5CreateTournamentCodeOptions{Count: &count})
+	require.NoError(t, err)
+	require.Len(t, codes, 20)
+
+	size, startIndex := 2, 0
+	leaderboard, err := client.VAL.RankedV1.LeaderboardWithOptions(ctx, val.BR, "act", &val.RankedV1LeaderboardOptions{Size: &size, StartIndex: &startIndex})
+	require.NoError(t, err)
+	require.Equal(t, "br", leaderboard.Shard)
+
+	locale := "en-US"
+	content, err := client.VAL.ContentV1.ContentWithOptions(ctx, val.NA, &val.ContentV1ContentOptions{Locale: &locale})
+	require.NoError(t, err)
+	require.Equal(t, "release-01.00", content.Version)
+
+	require.Equal(t, 3, httpmock.GetTotalCallCount())
+}
+
 func TestRateLimitWithMock(t *testing.T) {
 	httpmock.Activate()
 	defer httpmock.DeactivateAndReset()
@@ -92,8 +183,13 @@ func TestRateLimitWithMock(t *testing.T) {
 	httpmock.RegisterResponder("GET", "https://br1.api.riotgames.com/lol/summoner/v4/summoners/by-puuid/puuid",
 		httpmock.NewBytesResponder(200, []byte(`{}`)).HeaderSet(headers))
 
+	clock := equinoxtest.NewClock(time.Now())
+	clock.AutoAdvance = true
+	start := clock.Now()
+
 	config := util.NewTestEquinoxConfig()
 	config.Retry = api.Retry{MaxRetries: 3}
+	config.Clock = clock
 
 	client, err := equinox.NewCustomClient(config, nil, nil, ratelimit.NewInternalRateLimit(0.99, time.Second))
 	require.NoError(t, err)
@@ -122,8 +218,9 @@ func TestRateLimitWithMock(t *testing.T) {
 	_, err = client.LOL.SummonerV4.ByPUUID(ctx, lol.BR1, "puuid")
 	require.Equal(t, ratelimit.ErrContextDeadlineExceeded, err)
 
-	// This last request should block until rate limit is reset, this test should take around 4 seconds
+	// This last request should block until rate limit is reset, 3 seconds for the interval and 1 for the interval overhead
 	ctx = context.Background()
 	_, err = client.LOL.SummonerV4.ByPUUID(ctx, lol.BR1, "puuid")
 	require.NoError(t, err)
+	require.Equal(t, 4*time.Second, clock.Now().Sub(start))
 }
@@ -0,0 +1,76 @@
+package equinoxtest
+
+import (
+	"sync"
+	"time"
+)
+
+// Fake api.Clock for tests, time only moves with Advance or, if AutoAdvance is enabled, when waiting.
+//
+//	clock := equinoxtest.NewClock(time.Now())
+//	clock.AutoAdvance = true
+//	config.Clock = clock
+type Clock struct {
+	// Makes After advance the clock by the duration and fire immediately, waits return without blocking.
+	AutoAdvance bool
+	now         time.Time
+	waiters     []waiter
+	mutex       sync.Mutex
+}
+
+type waiter struct {
+	until   time.Time
+	channel chan time.Time
+}
+
+// Creates a new Clock starting at the given time.
+func NewClock(start time.Time) *Clock {
+	return &Clock{now: start}
+}
+
+func (c *Clock) Now() time.Time {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.now
+}
+
+func (c *Clock) After(duration time.Duration) <-chan time.Time {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	channel := make(chan time.Time, 1)
+	if c.AutoAdvance && duration > 0 {
+		c.now = c.now.Add(duration)
+	}
+	if duration <= 0 || c.AutoAdvance {
+		channel <- c.now
+		return channel
+	}
+
+	c.waiters = append(c.waiters, waiter{until: c.now.Add(duration), channel: channel})
+	return channel
+}
+
+// Moves the clock forward, firing every After channel that is due.
+func (c *Clock) Advance(duration time.Duration) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.now = c.now.Add(duration)
+	pending := c.waiters[:0]
+	for _, w := range c.waiters {
+		if w.until.After(c.now) {
+			pending = append(pending, w)
+			continue
+		}
+		w.channel <- c.now
+	}
+	c.waiters = pending
+}
+
+// Returns the number of After channels that have not fired, used to wait until a goroutine is blocked on the clock.
+func (c *Clock) Waiters() int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return len(c.waiters)
+}
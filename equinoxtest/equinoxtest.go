@@ -0,0 +1,22 @@
+// Fakes of the generated client interfaces, such as lol.ClientAPI and lol.MatchV5API, for unit tests that should not use the network.
+//
+// Every method of a fake calls the function field with the same name, set only the ones the test needs:
+//
+//	fake := &equinoxtest.LOL{}
+//	fake.MatchV5.ByIDFunc = func(ctx context.Context, route api.RegionalRoute, matchID string) (*lol.MatchV5DTO, error) {
+//		return &lol.MatchV5DTO{}, nil
+//	}
+//	var client lol.ClientAPI = fake
+//
+// Server is a fake of the Riot API itself, serving fixtures with rate limit headers to test a real client end-to-end:
+//
+//	server := equinoxtest.NewServer(nil)
+//	defer server.Close()
+//	err := server.HandleFile("match-v5.getMatch", nil, "testdata/match-v5.getMatch.json")
+//	client, err := equinox.NewCustomClient(config, server.HTTPClient(), nil, equinox.DefaultRateLimit())
+package equinoxtest
+
+import "errors"
+
+// Returned by a fake method when its function field is nil.
+var ErrNotStubbed = errors.New("method not stubbed")
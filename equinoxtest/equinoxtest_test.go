@@ -0,0 +1,66 @@
+package equinoxtest_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Kyagara/equinox/v2"
+	"github.com/Kyagara/equinox/v2/api"
+	"github.com/Kyagara/equinox/v2/clients/lol"
+	"github.com/Kyagara/equinox/v2/clients/lor"
+	"github.com/Kyagara/equinox/v2/clients/riot"
+	"github.com/Kyagara/equinox/v2/clients/tft"
+	"github.com/Kyagara/equinox/v2/clients/val"
+	"github.com/Kyagara/equinox/v2/equinoxtest"
+)
+
+// Counts the matches of a player, used to test code depending on the interfaces.
+func countMatches(ctx context.Context, client lol.ClientAPI, puuid string) (int, error) {
+	ids, err := client.MatchV5API().ListByPUUID(ctx, api.AMERICAS, puuid, -1, -1, -1, "", -1, 20)
+	return len(ids), err
+}
+
+func TestFakes(t *testing.T) {
+	client, err := equinox.NewClient("RGAPI-TEST")
+	require.NoError(t, err)
+
+	// Both the clients and the fakes implement the interfaces
+	clients := []any{client.Riot, client.LOL, client.TFT, client.VAL, client.LOR}
+	fakes := []any{&equinoxtest.Riot{}, &equinoxtest.LOL{}, &equinoxtest.TFT{}, &equinoxtest.VAL{}, &equinoxtest.LOR{}}
+	for _, c := range [][]any{clients, fakes} {
+		require.Implements(t, (*riot.ClientAPI)(nil), c[0])
+		require.Implements(t, (*lol.ClientAPI)(nil), c[1])
+		require.Implements(t, (*tft.ClientAPI)(nil), c[2])
+		require.Implements(t, (*val.ClientAPI)(nil), c[3])
+		require.Implements(t, (*lor.ClientAPI)(nil), c[4])
+	}
+
+	fake := &equinoxtest.LOL{}
+	ctx := context.Background()
+
+	_, err = countMatches(ctx, fake, "puuid")
+	require.ErrorIs(t, err, equinoxtest.ErrNotStubbed)
+
+	// The deprecated method calls the WithOptions variant
+	fake.MatchV5.ListByPUUIDWithOptionsFunc = func(ctx context.Context, route api.RegionalRoute, puuid string, opts *lol.MatchV5ListByPUUIDOptions) ([]string, error) {
+		require.Equal(t, api.AMERICAS, route)
+		require.Equal(t, "puuid", puuid)
+		require.Nil(t, opts.Queue)
+		require.Equal(t, 20, *opts.Count)
+		return []string{"BR1_1", "BR1_2"}, nil
+	}
+
+	count, err := countMatches(ctx, fake, "puuid")
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+
+	fake.MatchV5.ByIDFunc = func(ctx context.Context, route api.RegionalRoute, matchId string) (*lol.MatchV5DTO, error) {
+		return &lol.MatchV5DTO{Metadata: lol.MatchMetadataV5DTO{MatchID: matchId}}, nil
+	}
+
+	match, err := fake.MatchV5API().ByID(ctx, api.AMERICAS, "BR1_1")
+	require.NoError(t, err)
+	require.Equal(t, "BR1_1", match.Metadata.MatchID)
+}
@@ -0,0 +1,683 @@
+package equinoxtest
+
+///////////////////////////////////////////////
+//                                           //
+//                     !                     //
+//   This file is automatically generated!   //
+//           Do not directly edit!           //
+//                                           //
+///////////////////////////////////////////////
+
+// Spec version = c5f59a3e27f5101b78b8c7eb9b3fb88318b4225d
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Kyagara/equinox/v2/api"
+	"github.com/Kyagara/equinox/v2/clients/lol"
+)
+
+// Fake of lol.ClientAPI.
+type LOL struct {
+	ChampionMasteryV4 LOLChampionMasteryV4
+	ChampionV3        LOLChampionV3
+	ClashV1           LOLClashV1
+	LeagueExpV4       LOLLeagueExpV4
+	LeagueV4          LOLLeagueV4
+	ChallengesV1      LOLChallengesV1
+	RsoMatchV1        LOLRsoMatchV1
+	StatusV4          LOLStatusV4
+	MatchV5           LOLMatchV5
+	SpectatorV5       LOLSpectatorV5
+	SummonerV4        LOLSummonerV4
+	TournamentStubV5  LOLTournamentStubV5
+	TournamentV5      LOLTournamentV5
+}
+
+func (f *LOL) ChampionMasteryV4API() lol.ChampionMasteryV4API {
+	return &f.ChampionMasteryV4
+}
+
+func (f *LOL) ChampionV3API() lol.ChampionV3API {
+	return &f.ChampionV3
+}
+
+func (f *LOL) ClashV1API() lol.ClashV1API {
+	return &f.ClashV1
+}
+
+func (f *LOL) LeagueExpV4API() lol.LeagueExpV4API {
+	return &f.LeagueExpV4
+}
+
+func (f *LOL) LeagueV4API() lol.LeagueV4API {
+	return &f.LeagueV4
+}
+
+func (f *LOL) ChallengesV1API() lol.ChallengesV1API {
+	return &f.ChallengesV1
+}
+
+func (f *LOL) RsoMatchV1API() lol.RsoMatchV1API {
+	return &f.RsoMatchV1
+}
+
+func (f *LOL) StatusV4API() lol.StatusV4API {
+	return &f.StatusV4
+}
+
+func (f *LOL) MatchV5API() lol.MatchV5API {
+	return &f.MatchV5
+}
+
+func (f *LOL) SpectatorV5API() lol.SpectatorV5API {
+	return &f.SpectatorV5
+}
+
+func (f *LOL) SummonerV4API() lol.SummonerV4API {
+	return &f.SummonerV4
+}
+
+func (f *LOL) TournamentStubV5API() lol.TournamentStubV5API {
+	return &f.TournamentStubV5
+}
+
+func (f *LOL) TournamentV5API() lol.TournamentV5API {
+	return &f.TournamentV5
+}
+
+// Fake of lol.ChallengesV1API, methods call the function field of the same name and return ErrNotStubbed if it is nil.
+//
+// Deprecated methods call their WithOptions variant.
+type LOLChallengesV1 struct {
+	AllConfigsFunc              func(ctx context.Context, route lol.PlatformRoute) ([]lol.ChallengesChallengeConfigInfoV1DTO, error)
+	AllPercentilesFunc          func(ctx context.Context, route lol.PlatformRoute) (map[int]map[lol.Tier]float64, error)
+	ByPUUIDFunc                 func(ctx context.Context, route lol.PlatformRoute, puuid string) (*lol.ChallengesPlayerInfoV1DTO, error)
+	ConfigsFunc                 func(ctx context.Context, route lol.PlatformRoute, challengeId int) (*lol.ChallengesChallengeConfigInfoV1DTO, error)
+	LeaderboardsWithOptionsFunc func(ctx context.Context, route lol.PlatformRoute, challengeId int, level lol.Tier, opts *lol.ChallengesV1LeaderboardsOptions) ([]lol.ChallengesApexPlayerInfoV1DTO, error)
+	PercentilesFunc             func(ctx context.Context, route lol.PlatformRoute, challengeId int) (map[lol.Tier]float64, error)
+}
+
+func (f *LOLChallengesV1) AllConfigs(ctx context.Context, route lol.PlatformRoute) ([]lol.ChallengesChallengeConfigInfoV1DTO, error) {
+	if f.AllConfigsFunc == nil {
+		err := fmt.Errorf("%w: LOLChallengesV1.AllConfigs", ErrNotStubbed)
+		return nil, err
+	}
+	return f.AllConfigsFunc(ctx, route)
+}
+
+func (f *LOLChallengesV1) AllPercentiles(ctx context.Context, route lol.PlatformRoute) (map[int]map[lol.Tier]float64, error) {
+	if f.AllPercentilesFunc == nil {
+		err := fmt.Errorf("%w: LOLChallengesV1.AllPercentiles", ErrNotStubbed)
+		return nil, err
+	}
+	return f.AllPercentilesFunc(ctx, route)
+}
+
+func (f *LOLChallengesV1) ByPUUID(ctx context.Context, route lol.PlatformRoute, puuid string) (*lol.ChallengesPlayerInfoV1DTO, error) {
+	if f.ByPUUIDFunc == nil {
+		err := fmt.Errorf("%w: LOLChallengesV1.ByPUUID", ErrNotStubbed)
+		return nil, err
+	}
+	return f.ByPUUIDFunc(ctx, route, puuid)
+}
+
+func (f *LOLChallengesV1) Configs(ctx context.Context, route lol.PlatformRoute, challengeId int) (*lol.ChallengesChallengeConfigInfoV1DTO, error) {
+	if f.ConfigsFunc == nil {
+		err := fmt.Errorf("%w: LOLChallengesV1.Configs", ErrNotStubbed)
+		return nil, err
+	}
+	return f.ConfigsFunc(ctx, route, challengeId)
+}
+
+func (f *LOLChallengesV1) Leaderboards(ctx context.Context, route lol.PlatformRoute, challengeId int, level lol.Tier, limit int) ([]lol.ChallengesApexPlayerInfoV1DTO, error) {
+	opts := &lol.ChallengesV1LeaderboardsOptions{}
+	if limit != -1 {
+		opts.Limit = &limit
+	}
+	return f.LeaderboardsWithOptions(ctx, route, challengeId, level, opts)
+}
+
+func (f *LOLChallengesV1) LeaderboardsWithOptions(ctx context.Context, route lol.PlatformRoute, challengeId int, level lol.Tier, opts *lol.ChallengesV1LeaderboardsOptions) ([]lol.ChallengesApexPlayerInfoV1DTO, error) {
+	if f.LeaderboardsWithOptionsFunc == nil {
+		err := fmt.Errorf("%w: LOLChallengesV1.LeaderboardsWithOptions", ErrNotStubbed)
+		return nil, err
+	}
+	return f.LeaderboardsWithOptionsFunc(ctx, route, challengeId, level, opts)
+}
+
+func (f *LOLChallengesV1) Percentiles(ctx context.Context, route lol.PlatformRoute, challengeId int) (map[lol.Tier]float64, error) {
+	if f.PercentilesFunc == nil {
+		err := fmt.Errorf("%w: LOLChallengesV1.Percentiles", ErrNotStubbed)
+		return nil, err
+	}
+	return f.PercentilesFunc(ctx, route, challengeId)
+}
+
+// Fake of lol.ChampionMasteryV4API, methods call the function field of the same name and return ErrNotStubbed if it is nil.
+//
+// Deprecated methods call their WithOptions variant.
+type LOLChampionMasteryV4 struct {
+	AllMasteriesByPUUIDFunc            func(ctx context.Context, route lol.PlatformRoute, encryptedPUUID string) ([]lol.ChampionMasteryV4DTO, error)
+	MasteryByPUUIDFunc                 func(ctx context.Context, route lol.PlatformRoute, encryptedPUUID string, championId int) (*lol.ChampionMasteryV4DTO, error)
+	MasteryScoreByPUUIDFunc            func(ctx context.Context, route lol.PlatformRoute, encryptedPUUID string) (int, error)
+	TopMasteriesByPUUIDWithOptionsFunc func(ctx context.Context, route lol.PlatformRoute, encryptedPUUID string, opts *lol.ChampionMasteryV4TopMasteriesByPUUIDOptions) ([]lol.ChampionMasteryV4DTO, error)
+}
+
+func (f *LOLChampionMasteryV4) AllMasteriesByPUUID(ctx context.Context, route lol.PlatformRoute, encryptedPUUID string) ([]lol.ChampionMasteryV4DTO, error) {
+	if f.AllMasteriesByPUUIDFunc == nil {
+		err := fmt.Errorf("%w: LOLChampionMasteryV4.AllMasteriesByPUUID", ErrNotStubbed)
+		return nil, err
+	}
+	return f.AllMasteriesByPUUIDFunc(ctx, route, encryptedPUUID)
+}
+
+func (f *LOLChampionMasteryV4) MasteryByPUUID(ctx context.Context, route lol.PlatformRoute, encryptedPUUID string, championId int) (*lol.ChampionMasteryV4DTO, error) {
+	if f.MasteryByPUUIDFunc == nil {
+		err := fmt.Errorf("%w: LOLChampionMasteryV4.MasteryByPUUID", ErrNotStubbed)
+		return nil, err
+	}
+	return f.MasteryByPUUIDFunc(ctx, route, encryptedPUUID, championId)
+}
+
+func (f *LOLChampionMasteryV4) MasteryScoreByPUUID(ctx context.Context, route lol.PlatformRoute, encryptedPUUID string) (int, error) {
+	if f.MasteryScoreByPUUIDFunc == nil {
+		err := fmt.Errorf("%w: LOLChampionMasteryV4.MasteryScoreByPUUID", ErrNotStubbed)
+		return 0, err
+	}
+	return f.MasteryScoreByPUUIDFunc(ctx, route, encryptedPUUID)
+}
+
+func (f *LOLChampionMasteryV4) TopMasteriesByPUUID(ctx context.Context, route lol.PlatformRoute, encryptedPUUID string, count int) ([]lol.ChampionMasteryV4DTO, error) {
+	opts := &lol.ChampionMasteryV4TopMasteriesByPUUIDOptions{}
+	if count != -1 {
+		opts.Count = &count
+	}
+	return f.TopMasteriesByPUUIDWithOptions(ctx, route, encryptedPUUID, opts)
+}
+
+func (f *LOLChampionMasteryV4) TopMasteriesByPUUIDWithOptions(ctx context.Context, route lol.PlatformRoute, encryptedPUUID string, opts *lol.ChampionMasteryV4TopMasteriesByPUUIDOptions) ([]lol.ChampionMasteryV4DTO, error) {
+	if f.TopMasteriesByPUUIDWithOptionsFunc == nil {
+		err := fmt.Errorf("%w: LOLChampionMasteryV4.TopMasteriesByPUUIDWithOptions", ErrNotStubbed)
+		return nil, err
+	}
+	return f.TopMasteriesByPUUIDWithOptionsFunc(ctx, route, encryptedPUUID, opts)
+}
+
+// Fake of lol.ChampionV3API, methods call the function field of the same name and return ErrNotStubbed if it is nil.
+//
+// Deprecated methods call their WithOptions variant.
+type LOLChampionV3 struct {
+	RotationFunc func(ctx context.Context, route lol.PlatformRoute) (*lol.ChampionRotationV3DTO, error)
+}
+
+func (f *LOLChampionV3) Rotation(ctx context.Context, route lol.PlatformRoute) (*lol.ChampionRotationV3DTO, error) {
+	if f.RotationFunc == nil {
+		err := fmt.Errorf("%w: LOLChampionV3.Rotation", ErrNotStubbed)
+		return nil, err
+	}
+	return f.RotationFunc(ctx, route)
+}
+
+// Fake of lol.ClashV1API, methods call the function field of the same name and return ErrNotStubbed if it is nil.
+//
+// Deprecated methods call their WithOptions variant.
+type LOLClashV1 struct {
+	ByIDFunc                   func(ctx context.Context, route lol.PlatformRoute, tournamentId int) (*lol.ClashTournamentV1DTO, error)
+	ByTeamIDFunc               func(ctx context.Context, route lol.PlatformRoute, teamId string) (*lol.ClashTournamentV1DTO, error)
+	SummonerEntriesByPUUIDFunc func(ctx context.Context, route lol.PlatformRoute, puuid string) ([]lol.ClashPlayerV1DTO, error)
+	TeamByTeamIDFunc           func(ctx context.Context, route lol.PlatformRoute, teamId string) (*lol.ClashTeamV1DTO, error)
+	TournamentsFunc            func(ctx context.Context, route lol.PlatformRoute) ([]lol.ClashTournamentV1DTO, error)
+}
+
+func (f *LOLClashV1) ByID(ctx context.Context, route lol.PlatformRoute, tournamentId int) (*lol.ClashTournamentV1DTO, error) {
+	if f.ByIDFunc == nil {
+		err := fmt.Errorf("%w: LOLClashV1.ByID", ErrNotStubbed)
+		return nil, err
+	}
+	return f.ByIDFunc(ctx, route, tournamentId)
+}
+
+func (f *LOLClashV1) ByTeamID(ctx context.Context, route lol.PlatformRoute, teamId string) (*lol.ClashTournamentV1DTO, error) {
+	if f.ByTeamIDFunc == nil {
+		err := fmt.Errorf("%w: LOLClashV1.ByTeamID", ErrNotStubbed)
+		return nil, err
+	}
+	return f.ByTeamIDFunc(ctx, route, teamId)
+}
+
+func (f *LOLClashV1) SummonerEntriesByPUUID(ctx context.Context, route lol.PlatformRoute, puuid string) ([]lol.ClashPlayerV1DTO, error) {
+	if f.SummonerEntriesByPUUIDFunc == nil {
+		err := fmt.Errorf("%w: LOLClashV1.SummonerEntriesByPUUID", ErrNotStubbed)
+		return nil, err
+	}
+	return f.SummonerEntriesByPUUIDFunc(ctx, route, puuid)
+}
+
+func (f *LOLClashV1) TeamByTeamID(ctx context.Context, route lol.PlatformRoute, teamId string) (*lol.ClashTeamV1DTO, error) {
+	if f.TeamByTeamIDFunc == nil {
+		err := fmt.Errorf("%w: LOLClashV1.TeamByTeamID", ErrNotStubbed)
+		return nil, err
+	}
+	return f.TeamByTeamIDFunc(ctx, route, teamId)
+}
+
+func (f *LOLClashV1) Tournaments(ctx context.Context, route lol.PlatformRoute) ([]lol.ClashTournamentV1DTO, error) {
+	if f.TournamentsFunc == nil {
+		err := fmt.Errorf("%w: LOLClashV1.Tournaments", ErrNotStubbed)
+		return nil, err
+	}
+	return f.TournamentsFunc(ctx, route)
+}
+
+// Fake of lol.LeagueExpV4API, methods call the function field of the same name and return ErrNotStubbed if it is nil.
+//
+// Deprecated methods call their WithOptions variant.
+type LOLLeagueExpV4 struct {
+	EntriesWithOptionsFunc func(ctx context.Context, route lol.PlatformRoute, queue lol.QueueType, tier lol.Tier, division lol.Division, opts *lol.LeagueExpV4EntriesOptions) ([]lol.LeagueExpLeagueEntryV4DTO, error)
+}
+
+func (f *LOLLeagueExpV4) Entries(ctx context.Context, route lol.PlatformRoute, queue lol.QueueType, tier lol.Tier, division lol.Division, page int) ([]lol.LeagueExpLeagueEntryV4DTO, error) {
+	opts := &lol.LeagueExpV4EntriesOptions{}
+	if page != -1 {
+		opts.Page = &page
+	}
+	return f.EntriesWithOptions(ctx, route, queue, tier, division, opts)
+}
+
+func (f *LOLLeagueExpV4) EntriesWithOptions(ctx context.Context, route lol.PlatformRoute, queue lol.QueueType, tier lol.Tier, division lol.Division, opts *lol.LeagueExpV4EntriesOptions) ([]lol.LeagueExpLeagueEntryV4DTO, error) {
+	if f.EntriesWithOptionsFunc == nil {
+		err := fmt.Errorf("%w: LOLLeagueExpV4.EntriesWithOptions", ErrNotStubbed)
+		return nil, err
+	}
+	return f.EntriesWithOptionsFunc(ctx, route, queue, tier, division, opts)
+}
+
+// Fake of lol.LeagueV4API, methods call the function field of the same name and return ErrNotStubbed if it is nil.
+//
+// Deprecated methods call their WithOptions variant.
+type LOLLeagueV4 struct {
+	ByIDFunc               func(ctx context.Context, route lol.PlatformRoute, leagueId string) (*lol.LeagueListV4DTO, error)
+	ChallengerByQueueFunc  func(ctx context.Context, route lol.PlatformRoute, queue lol.QueueType) (*lol.LeagueListV4DTO, error)
+	EntriesWithOptionsFunc func(ctx context.Context, route lol.PlatformRoute, queue lol.QueueType, tier lol.Tier, division lol.Division, opts *lol.LeagueV4EntriesOptions) ([]lol.LeagueEntryV4DTO, error)
+	EntriesByPUUIDFunc     func(ctx context.Context, route lol.PlatformRoute, encryptedPUUID string) ([]lol.LeagueEntryV4DTO, error)
+	GrandmasterByQueueFunc func(ctx context.Context, route lol.PlatformRoute, queue lol.QueueType) (*lol.LeagueListV4DTO, error)
+	MasterByQueueFunc      func(ctx context.Context, route lol.PlatformRoute, queue lol.QueueType) (*lol.LeagueListV4DTO, error)
+}
+
+func (f *LOLLeagueV4) ByID(ctx context.Context, route lol.PlatformRoute, leagueId string) (*lol.LeagueListV4DTO, error) {
+	if f.ByIDFunc == nil {
+		err := fmt.Errorf("%w: LOLLeagueV4.ByID", ErrNotStubbed)
+		return nil, err
+	}
+	return f.ByIDFunc(ctx, route, leagueId)
+}
+
+func (f *LOLLeagueV4) ChallengerByQueue(ctx context.Context, route lol.PlatformRoute, queue lol.QueueType) (*lol.LeagueListV4DTO, error) {
+	if f.ChallengerByQueueFunc == nil {
+		err := fmt.Errorf("%w: LOLLeagueV4.ChallengerByQueue", ErrNotStubbed)
+		return nil, err
+	}
+	return f.ChallengerByQueueFunc(ctx, route, queue)
+}
+
+func (f *LOLLeagueV4) Entries(ctx context.Context, route lol.PlatformRoute, queue lol.QueueType, tier lol.Tier, division lol.Division, page int) ([]lol.LeagueEntryV4DTO, error) {
+	opts := &lol.LeagueV4EntriesOptions{}
+	if page != -1 {
+		opts.Page = &page
+	}
+	return f.EntriesWithOptions(ctx, route, queue, tier, division, opts)
+}
+
+func (f *LOLLeagueV4) EntriesWithOptions(ctx context.Context, route lol.PlatformRoute, queue lol.QueueType, tier lol.Tier, division lol.Division, opts *lol.LeagueV4EntriesOptions) ([]lol.LeagueEntryV4DTO, error) {
+	if f.EntriesWithOptionsFunc == nil {
+		err := fmt.Errorf("%w: LOLLeagueV4.EntriesWithOptions", ErrNotStubbed)
+		return nil, err
+	}
+	return f.EntriesWithOptionsFunc(ctx, route, queue, tier, division, opts)
+}
+
+func (f *LOLLeagueV4) EntriesByPUUID(ctx context.Context, route lol.PlatformRoute, encryptedPUUID string) ([]lol.LeagueEntryV4DTO, error) {
+	if f.EntriesByPUUIDFunc == nil {
+		err := fmt.Errorf("%w: LOLLeagueV4.EntriesByPUUID", ErrNotStubbed)
+		return nil, err
+	}
+	return f.EntriesByPUUIDFunc(ctx, route, encryptedPUUID)
+}
+
+func (f *LOLLeagueV4) GrandmasterByQueue(ctx context.Context, route lol.PlatformRoute, queue lol.QueueType) (*lol.LeagueListV4DTO, error) {
+	if f.GrandmasterByQueueFunc == nil {
+		err := fmt.Errorf("%w: LOLLeagueV4.GrandmasterByQueue", ErrNotStubbed)
+		return nil, err
+	}
+	return f.GrandmasterByQueueFunc(ctx, route, queue)
+}
+
+func (f *LOLLeagueV4) MasterByQueue(ctx context.Context, route lol.PlatformRoute, queue lol.QueueType) (*lol.LeagueListV4DTO, error) {
+	if f.MasterByQueueFunc == nil {
+		err := fmt.Errorf("%w: LOLLeagueV4.MasterByQueue", ErrNotStubbed)
+		return nil, err
+	}
+	return f.MasterByQueueFunc(ctx, route, queue)
+}
+
+// Fake of lol.MatchV5API, methods call the function field of the same name and return ErrNotStubbed if it is nil.
+//
+// Deprecated methods call their WithOptions variant.
+type LOLMatchV5 struct {
+	ByIDFunc                   func(ctx context.Context, route api.RegionalRoute, matchId string) (*lol.MatchV5DTO, error)
+	ListByPUUIDWithOptionsFunc func(ctx context.Context, route api.RegionalRoute, puuid string, opts *lol.MatchV5ListByPUUIDOptions) ([]string, error)
+	TimelineFunc               func(ctx context.Context, route api.RegionalRoute, matchId string) (*lol.MatchTimelineV5DTO, error)
+}
+
+func (f *LOLMatchV5) ByID(ctx context.Context, route api.RegionalRoute, matchId string) (*lol.MatchV5DTO, error) {
+	if f.ByIDFunc == nil {
+		err := fmt.Errorf("%w: LOLMatchV5.ByID", ErrNotStubbed)
+		return nil, err
+	}
+	return f.ByIDFunc(ctx, route, matchId)
+}
+
+func (f *LOLMatchV5) ListByPUUID(ctx context.Context, route api.RegionalRoute, puuid string, startTime int, endTime int, queue lol.Queue, matchType string, start int, count int) ([]string, error) {
+	opts := &lol.MatchV5ListByPUUIDOptions{}
+	if startTime != -1 {
+		opts.StartTime = &startTime
+	}
+	if endTime != -1 {
+		opts.EndTime = &endTime
+	}
+	if queue != -1 {
+		opts.Queue = &queue
+	}
+	if matchType != "" {
+		opts.Type = &matchType
+	}
+	if start != -1 {
+		opts.Start = &start
+	}
+	if count != -1 {
+		opts.Count = &count
+	}
+	return f.ListByPUUIDWithOptions(ctx, route, puuid, opts)
+}
+
+func (f *LOLMatchV5) ListByPUUIDWithOptions(ctx context.Context, route api.RegionalRoute, puuid string, opts *lol.MatchV5ListByPUUIDOptions) ([]string, error) {
+	if f.ListByPUUIDWithOptionsFunc == nil {
+		err := fmt.Errorf("%w: LOLMatchV5.ListByPUUIDWithOptions", ErrNotStubbed)
+		return nil, err
+	}
+	return f.ListByPUUIDWithOptionsFunc(ctx, route, puuid, opts)
+}
+
+func (f *LOLMatchV5) Timeline(ctx context.Context, route api.RegionalRoute, matchId string) (*lol.MatchTimelineV5DTO, error) {
+	if f.TimelineFunc == nil {
+		err := fmt.Errorf("%w: LOLMatchV5.Timeline", ErrNotStubbed)
+		return nil, err
+	}
+	return f.TimelineFunc(ctx, route, matchId)
+}
+
+// Fake of lol.RsoMatchV1API, methods call the function field of the same name and return ErrNotStubbed if it is nil.
+//
+// Deprecated methods call their WithOptions variant.
+type LOLRsoMatchV1 struct {
+	ByIDFunc                func(ctx context.Context, route api.RegionalRoute, accessToken string, matchId string) (*lol.MatchV5DTO, error)
+	MatchIdsWithOptionsFunc func(ctx context.Context, route api.RegionalRoute, accessToken string, opts *lol.RsoMatchV1MatchIdsOptions) ([]string, error)
+	TimelineFunc            func(ctx context.Context, route api.RegionalRoute, accessToken string, matchId string) (*lol.MatchTimelineV5DTO, error)
+}
+
+func (f *LOLRsoMatchV1) ByID(ctx context.Context, route api.RegionalRoute, accessToken string, matchId string) (*lol.MatchV5DTO, error) {
+	if f.ByIDFunc == nil {
+		err := fmt.Errorf("%w: LOLRsoMatchV1.ByID", ErrNotStubbed)
+		return nil, err
+	}
+	return f.ByIDFunc(ctx, route, accessToken, matchId)
+}
+
+func (f *LOLRsoMatchV1) MatchIds(ctx context.Context, route api.RegionalRoute, accessToken string, count int, start int, matchType string, queue int, endTime int, startTime int) ([]string, error) {
+	opts := &lol.RsoMatchV1MatchIdsOptions{}
+	if count != -1 {
+		opts.Count = &count
+	}
+	if start != -1 {
+		opts.Start = &start
+	}
+	if matchType != "" {
+		opts.Type = &matchType
+	}
+	if queue != -1 {
+		opts.Queue = &queue
+	}
+	if endTime != -1 {
+		opts.EndTime = &endTime
+	}
+	if startTime != -1 {
+		opts.StartTime = &startTime
+	}
+	return f.MatchIdsWithOptions(ctx, route, accessToken, opts)
+}
+
+func (f *LOLRsoMatchV1) MatchIdsWithOptions(ctx context.Context, route api.RegionalRoute, accessToken string, opts *lol.RsoMatchV1MatchIdsOptions) ([]string, error) {
+	if f.MatchIdsWithOptionsFunc == nil {
+		err := fmt.Errorf("%w: LOLRsoMatchV1.MatchIdsWithOptions", ErrNotStubbed)
+		return nil, err
+	}
+	return f.MatchIdsWithOptionsFunc(ctx, route, accessToken, opts)
+}
+
+func (f *LOLRsoMatchV1) Timeline(ctx context.Context, route api.RegionalRoute, accessToken string, matchId string) (*lol.MatchTimelineV5DTO, error) {
+	if f.TimelineFunc == nil {
+		err := fmt.Errorf("%w: LOLRsoMatchV1.Timeline", ErrNotStubbed)
+		return nil, err
+	}
+	return f.TimelineFunc(ctx, route, accessToken, matchId)
+}
+
+// Fake of lol.SpectatorV5API, methods call the function field of the same name and return ErrNotStubbed if it is nil.
+//
+// Deprecated methods call their WithOptions variant.
+type LOLSpectatorV5 struct {
+	CurrentGameInfoByPUUIDFunc func(ctx context.Context, route lol.PlatformRoute, encryptedPUUID string) (*lol.SpectatorCurrentGameInfoV5DTO, error)
+	FeaturedFunc               func(ctx context.Context, route lol.PlatformRoute) (*lol.SpectatorFeaturedGamesV5DTO, error)
+}
+
+func (f *LOLSpectatorV5) CurrentGameInfoByPUUID(ctx context.Context, route lol.PlatformRoute, encryptedPUUID string) (*lol.SpectatorCurrentGameInfoV5DTO, error) {
+	if f.CurrentGameInfoByPUUIDFunc == nil {
+		err := fmt.Errorf("%w: LOLSpectatorV5.CurrentGameInfoByPUUID", ErrNotStubbed)
+		return nil, err
+	}
+	return f.CurrentGameInfoByPUUIDFunc(ctx, route, encryptedPUUID)
+}
+
+func (f *LOLSpectatorV5) Featured(ctx context.Context, route lol.PlatformRoute) (*lol.SpectatorFeaturedGamesV5DTO, error) {
+	if f.FeaturedFunc == nil {
+		err := fmt.Errorf("%w: LOLSpectatorV5.Featured", ErrNotStubbed)
+		return nil, err
+	}
+	return f.FeaturedFunc(ctx, route)
+}
+
+// Fake of lol.StatusV4API, methods call the function field of the same name and return ErrNotStubbed if it is nil.
+//
+// Deprecated methods call their WithOptions variant.
+type LOLStatusV4 struct {
+	PlatformFunc func(ctx context.Context, route lol.PlatformRoute) (*lol.StatusPlatformDataV4DTO, error)
+}
+
+func (f *LOLStatusV4) Platform(ctx context.Context, route lol.PlatformRoute) (*lol.StatusPlatformDataV4DTO, error) {
+	if f.PlatformFunc == nil {
+		err := fmt.Errorf("%w: LOLStatusV4.Platform", ErrNotStubbed)
+		return nil, err
+	}
+	return f.PlatformFunc(ctx, route)
+}
+
+// Fake of lol.SummonerV4API, methods call the function field of the same name and return ErrNotStubbed if it is nil.
+//
+// Deprecated methods call their WithOptions variant.
+type LOLSummonerV4 struct {
+	ByAccessTokenFunc func(ctx context.Context, route lol.PlatformRoute, accessToken string) (*lol.SummonerV4DTO, error)
+	ByPUUIDFunc       func(ctx context.Context, route lol.PlatformRoute, encryptedPUUID string) (*lol.SummonerV4DTO, error)
+	ByRSOPUUIDFunc    func(ctx context.Context, route lol.PlatformRoute, rsoPUUID string) (*lol.SummonerV4DTO, error)
+}
+
+func (f *LOLSummonerV4) ByAccessToken(ctx context.Context, route lol.PlatformRoute, accessToken string) (*lol.SummonerV4DTO, error) {
+	if f.ByAccessTokenFunc == nil {
+		err := fmt.Errorf("%w: LOLSummonerV4.ByAccessToken", ErrNotStubbed)
+		return nil, err
+	}
+	return f.ByAccessTokenFunc(ctx, route, accessToken)
+}
+
+func (f *LOLSummonerV4) ByPUUID(ctx context.Context, route lol.PlatformRoute, encryptedPUUID string) (*lol.SummonerV4DTO, error) {
+	if f.ByPUUIDFunc == nil {
+		err := fmt.Errorf("%w: LOLSummonerV4.ByPUUID", ErrNotStubbed)
+		return nil, err
+	}
+	return f.ByPUUIDFunc(ctx, route, encryptedPUUID)
+}
+
+func (f *LOLSummonerV4) ByRSOPUUID(ctx context.Context, route lol.PlatformRoute, rsoPUUID string) (*lol.SummonerV4DTO, error) {
+	if f.ByRSOPUUIDFunc == nil {
+		err := fmt.Errorf("%w: LOLSummonerV4.ByRSOPUUID", ErrNotStubbed)
+		return nil, err
+	}
+	return f.ByRSOPUUIDFunc(ctx, route, rsoPUUID)
+}
+
+// Fake of lol.TournamentStubV5API, methods call the function field of the same name and return ErrNotStubbed if it is nil.
+//
+// Deprecated methods call their WithOptions variant.
+type LOLTournamentStubV5 struct {
+	CreateTournamentCodeWithOptionsFunc func(ctx context.Context, route api.RegionalRoute, body *lol.TournamentStubCodeParametersV5DTO, tournamentId int, opts *lol.TournamentStubV5CreateTournamentCodeOptions) ([]string, error)
+	LobbyEventsByCodeFunc               func(ctx context.Context, route api.RegionalRoute, tournamentCode string) (*lol.TournamentStubLobbyEventWrapperV5DTO, error)
+	RegisterProviderDataFunc            func(ctx context.Context, route api.RegionalRoute, body *lol.TournamentStubProviderRegistrationParametersV5DTO) (int, error)
+	RegisterTournamentFunc              func(ctx context.Context, route api.RegionalRoute, body *lol.TournamentStubRegistrationParametersV5DTO) (int, error)
+	TournamentCodeFunc                  func(ctx context.Context, route api.RegionalRoute, tournamentCode string) (*lol.TournamentStubCodeV5DTO, error)
+}
+
+func (f *LOLTournamentStubV5) CreateTournamentCode(ctx context.Context, route api.RegionalRoute, body *lol.TournamentStubCodeParametersV5DTO, count int, tournamentId int) ([]string, error) {
+	opts := &lol.TournamentStubV5CreateTournamentCodeOptions{}
+	if count != -1 {
+		opts.Count = &count
+	}
+	return f.CreateTournamentCodeWithOptions(ctx, route, body, tournamentId, opts)
+}
+
+func (f *LOLTournamentStubV5) CreateTournamentCodeWithOptions(ctx context.Context, route api.RegionalRoute, body *lol.TournamentStubCodeParametersV5DTO, tournamentId int, opts *lol.TournamentStubV5CreateTournamentCodeOptions) ([]string, error) {
+	if f.CreateTournamentCodeWithOptionsFunc == nil {
+		err := fmt.Errorf("%w: LOLTournamentStubV5.CreateTournamentCodeWithOptions", ErrNotStubbed)
+		return nil, err
+	}
+	return f.CreateTournamentCodeWithOptionsFunc(ctx, route, body, tournamentId, opts)
+}
+
+func (f *LOLTournamentStubV5) LobbyEventsByCode(ctx context.Context, route api.RegionalRoute, tournamentCode string) (*lol.TournamentStubLobbyEventWrapperV5DTO, error) {
+	if f.LobbyEventsByCodeFunc == nil {
+		err := fmt.Errorf("%w: LOLTournamentStubV5.LobbyEventsByCode", ErrNotStubbed)
+		return nil, err
+	}
+	return f.LobbyEventsByCodeFunc(ctx, route, tournamentCode)
+}
+
+func (f *LOLTournamentStubV5) RegisterProviderData(ctx context.Context, route api.RegionalRoute, body *lol.TournamentStubProviderRegistrationParametersV5DTO) (int, error) {
+	if f.RegisterProviderDataFunc == nil {
+		err := fmt.Errorf("%w: LOLTournamentStubV5.RegisterProviderData", ErrNotStubbed)
+		return 0, err
+	}
+	return f.RegisterProviderDataFunc(ctx, route, body)
+}
+
+func (f *LOLTournamentStubV5) RegisterTournament(ctx context.Context, route api.RegionalRoute, body *lol.TournamentStubRegistrationParametersV5DTO) (int, error) {
+	if f.RegisterTournamentFunc == nil {
+		err := fmt.Errorf("%w: LOLTournamentStubV5.RegisterTournament", ErrNotStubbed)
+		return 0, err
+	}
+	return f.RegisterTournamentFunc(ctx, route, body)
+}
+
+func (f *LOLTournamentStubV5) TournamentCode(ctx context.Context, route api.RegionalRoute, tournamentCode string) (*lol.TournamentStubCodeV5DTO, error) {
+	if f.TournamentCodeFunc == nil {
+		err := fmt.Errorf("%w: LOLTournamentStubV5.TournamentCode", ErrNotStubbed)
+		return nil, err
+	}
+	return f.TournamentCodeFunc(ctx, route, tournamentCode)
+}
+
+// Fake of lol.TournamentV5API, methods call the function field of the same name and return ErrNotStubbed if it is nil.
+//
+// Deprecated methods call their WithOptions variant.
+type LOLTournamentV5 struct {
+	CreateTournamentCodeWithOptionsFunc func(ctx context.Context, route api.RegionalRoute, body *lol.TournamentCodeParametersV5DTO, tournamentId int, opts *lol.TournamentV5CreateTournamentCodeOptions) ([]string, error)
+	GamesFunc                           func(ctx context.Context, route api.RegionalRoute, tournamentCode string) ([]lol.TournamentGamesV5DTO, error)
+	LobbyEventsByCodeFunc               func(ctx context.Context, route api.RegionalRoute, tournamentCode string) (*lol.TournamentLobbyEventWrapperV5DTO, error)
+	RegisterProviderDataFunc            func(ctx context.Context, route api.RegionalRoute, body *lol.TournamentProviderRegistrationParametersV5DTO) (int, error)
+	RegisterTournamentFunc              func(ctx context.Context, route api.RegionalRoute, body *lol.TournamentRegistrationParametersV5DTO) (int, error)
+	TournamentCodeFunc                  func(ctx context.Context, route api.RegionalRoute, tournamentCode string) (*lol.TournamentCodeV5DTO, error)
+	UpdateCodeFunc                      func(ctx context.Context, route api.RegionalRoute, body *lol.TournamentCodeUpdateParametersV5DTO, tournamentCode string) error
+}
+
+func (f *LOLTournamentV5) CreateTournamentCode(ctx context.Context, route api.RegionalRoute, body *lol.TournamentCodeParametersV5DTO, tournamentId int, count int) ([]string, error) {
+	opts := &lol.TournamentV5CreateTournamentCodeOptions{}
+	if count != -1 {
+		opts.Count = &count
+	}
+	return f.CreateTournamentCodeWithOptions(ctx, route, body, tournamentId, opts)
+}
+
+func (f *LOLTournamentV5) CreateTournamentCodeWithOptions(ctx context.Context, route api.RegionalRoute, body *lol.TournamentCodeParametersV5DTO, tournamentId int, opts *lol.TournamentV5CreateTournamentCodeOptions) ([]string, error) {
+	if f.CreateTournamentCodeWithOptionsFunc == nil {
+		err := fmt.Errorf("%w: LOLTournamentV5.CreateTournamentCodeWithOptions", ErrNotStubbed)
+		return nil, err
+	}
+	return f.CreateTournamentCodeWithOptionsFunc(ctx, route, body, tournamentId, opts)
+}
+
+func (f *LOLTournamentV5) Games(ctx context.Context, route api.RegionalRoute, tournamentCode string) ([]lol.TournamentGamesV5DTO, error) {
+	if f.GamesFunc == nil {
+		err := fmt.Errorf("%w: LOLTournamentV5.Games", ErrNotStubbed)
+		return nil, err
+	}
+	return f.GamesFunc(ctx, route, tournamentCode)
+}
+
+func (f *LOLTournamentV5) LobbyEventsByCode(ctx context.Context, route api.RegionalRoute, tournamentCode string) (*lol.TournamentLobbyEventWrapperV5DTO, error) {
+	if f.LobbyEventsByCodeFunc == nil {
+		err := fmt.Errorf("%w: LOLTournamentV5.LobbyEventsByCode", ErrNotStubbed)
+		return nil, err
+	}
+	return f.LobbyEventsByCodeFunc(ctx, route, tournamentCode)
+}
+
+func (f *LOLTournamentV5) RegisterProviderData(ctx context.Context, route api.RegionalRoute, body *lol.TournamentProviderRegistrationParametersV5DTO) (int, error) {
+	if f.RegisterProviderDataFunc == nil {
+		err := fmt.Errorf("%w: LOLTournamentV5.RegisterProviderData", ErrNotStubbed)
+		return 0, err
+	}
+	return f.RegisterProviderDataFunc(ctx, route, body)
+}
+
+func (f *LOLTournamentV5) RegisterTournament(ctx context.Context, route api.RegionalRoute, body *lol.TournamentRegistrationParametersV5DTO) (int, error) {
+	if f.RegisterTournamentFunc == nil {
+		err := fmt.Errorf("%w: LOLTournamentV5.RegisterTournament", ErrNotStubbed)
+		return 0, err
+	}
+	return f.RegisterTournamentFunc(ctx, route, body)
+}
+
+func (f *LOLTournamentV5) TournamentCode(ctx context.Context, route api.RegionalRoute, tournamentCode string) (*lol.TournamentCodeV5DTO, error) {
+	if f.TournamentCodeFunc == nil {
+		err := fmt.Errorf("%w: LOLTournamentV5.TournamentCode", ErrNotStubbed)
+		return nil, err
+	}
+	return f.TournamentCodeFunc(ctx, route, tournamentCode)
+}
+
+func (f *LOLTournamentV5) UpdateCode(ctx context.Context, route api.RegionalRoute, body *lol.TournamentCodeUpdateParametersV5DTO, tournamentCode string) error {
+	if f.UpdateCodeFunc == nil {
+		err := fmt.Errorf("%w: LOLTournamentV5.UpdateCode", ErrNotStubbed)
+		return err
+	}
+	return f.UpdateCodeFunc(ctx, route, body, tournamentCode)
+}
@@ -0,0 +1,141 @@
+package equinoxtest
+
+///////////////////////////////////////////////
+//                                           //
+//                     !                     //
+//   This file is automatically generated!   //
+//           Do not directly edit!           //
+//                                           //
+///////////////////////////////////////////////
+
+// Spec version = c5f59a3e27f5101b78b8c7eb9b3fb88318b4225d
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Kyagara/equinox/v2/api"
+	"github.com/Kyagara/equinox/v2/clients/lor"
+)
+
+// Fake of lor.ClientAPI.
+type LOR struct {
+	DeckV1      LORDeckV1
+	InventoryV1 LORInventoryV1
+	MatchV1     LORMatchV1
+	RankedV1    LORRankedV1
+	StatusV1    LORStatusV1
+}
+
+func (f *LOR) DeckV1API() lor.DeckV1API {
+	return &f.DeckV1
+}
+
+func (f *LOR) InventoryV1API() lor.InventoryV1API {
+	return &f.InventoryV1
+}
+
+func (f *LOR) MatchV1API() lor.MatchV1API {
+	return &f.MatchV1
+}
+
+func (f *LOR) RankedV1API() lor.RankedV1API {
+	return &f.RankedV1
+}
+
+func (f *LOR) StatusV1API() lor.StatusV1API {
+	return &f.StatusV1
+}
+
+// Fake of lor.DeckV1API, methods call the function field of the same name and return ErrNotStubbed if it is nil.
+//
+// Deprecated methods call their WithOptions variant.
+type LORDeckV1 struct {
+	CreateDeckFunc func(ctx context.Context, route api.RegionalRoute, accessToken string, body *lor.DeckNewDeckV1DTO) (string, error)
+	DecksFunc      func(ctx context.Context, route api.RegionalRoute, accessToken string) ([]lor.DeckV1DTO, error)
+}
+
+func (f *LORDeckV1) CreateDeck(ctx context.Context, route api.RegionalRoute, accessToken string, body *lor.DeckNewDeckV1DTO) (string, error) {
+	if f.CreateDeckFunc == nil {
+		err := fmt.Errorf("%w: LORDeckV1.CreateDeck", ErrNotStubbed)
+		return "", err
+	}
+	return f.CreateDeckFunc(ctx, route, accessToken, body)
+}
+
+func (f *LORDeckV1) Decks(ctx context.Context, route api.RegionalRoute, accessToken string) ([]lor.DeckV1DTO, error) {
+	if f.DecksFunc == nil {
+		err := fmt.Errorf("%w: LORDeckV1.Decks", ErrNotStubbed)
+		return nil, err
+	}
+	return f.DecksFunc(ctx, route, accessToken)
+}
+
+// Fake of lor.InventoryV1API, methods call the function field of the same name and return ErrNotStubbed if it is nil.
+//
+// Deprecated methods call their WithOptions variant.
+type LORInventoryV1 struct {
+	CardsFunc func(ctx context.Context, route api.RegionalRoute, accessToken string) ([]lor.InventoryCardV1DTO, error)
+}
+
+func (f *LORInventoryV1) Cards(ctx context.Context, route api.RegionalRoute, accessToken string) ([]lor.InventoryCardV1DTO, error) {
+	if f.CardsFunc == nil {
+		err := fmt.Errorf("%w: LORInventoryV1.Cards", ErrNotStubbed)
+		return nil, err
+	}
+	return f.CardsFunc(ctx, route, accessToken)
+}
+
+// Fake of lor.MatchV1API, methods call the function field of the same name and return ErrNotStubbed if it is nil.
+//
+// Deprecated methods call their WithOptions variant.
+type LORMatchV1 struct {
+	ByIDFunc        func(ctx context.Context, route api.RegionalRoute, matchId string) (*lor.MatchV1DTO, error)
+	ListByPUUIDFunc func(ctx context.Context, route api.RegionalRoute, puuid string) ([]string, error)
+}
+
+func (f *LORMatchV1) ByID(ctx context.Context, route api.RegionalRoute, matchId string) (*lor.MatchV1DTO, error) {
+	if f.ByIDFunc == nil {
+		err := fmt.Errorf("%w: LORMatchV1.ByID", ErrNotStubbed)
+		return nil, err
+	}
+	return f.ByIDFunc(ctx, route, matchId)
+}
+
+func (f *LORMatchV1) ListByPUUID(ctx context.Context, route api.RegionalRoute, puuid string) ([]string, error) {
+	if f.ListByPUUIDFunc == nil {
+		err := fmt.Errorf("%w: LORMatchV1.ListByPUUID", ErrNotStubbed)
+		return nil, err
+	}
+	return f.ListByPUUIDFunc(ctx, route, puuid)
+}
+
+// Fake of lor.RankedV1API, methods call the function field of the same name and return ErrNotStubbed if it is nil.
+//
+// Deprecated methods call their WithOptions variant.
+type LORRankedV1 struct {
+	LeaderboardsFunc func(ctx context.Context, route api.RegionalRoute) (*lor.RankedLeaderboardV1DTO, error)
+}
+
+func (f *LORRankedV1) Leaderboards(ctx context.Context, route api.RegionalRoute) (*lor.RankedLeaderboardV1DTO, error) {
+	if f.LeaderboardsFunc == nil {
+		err := fmt.Errorf("%w: LORRankedV1.Leaderboards", ErrNotStubbed)
+		return nil, err
+	}
+	return f.LeaderboardsFunc(ctx, route)
+}
+
+// Fake of lor.StatusV1API, methods call the function field of the same name and return ErrNotStubbed if it is nil.
+//
+// Deprecated methods call their WithOptions variant.
+type LORStatusV1 struct {
+	PlatformFunc func(ctx context.Context, route api.RegionalRoute) (*lor.StatusPlatformDataV1DTO, error)
+}
+
+func (f *LORStatusV1) Platform(ctx context.Context, route api.RegionalRoute) (*lor.StatusPlatformDataV1DTO, error) {
+	if f.PlatformFunc == nil {
+		err := fmt.Errorf("%w: LORStatusV1.Platform", ErrNotStubbed)
+		return nil, err
+	}
+	return f.PlatformFunc(ctx, route)
+}
@@ -0,0 +1,252 @@
+package equinoxtest
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	jsonv2 "github.com/go-json-experiment/json"
+	"github.com/go-json-experiment/json/jsontext"
+
+	"github.com/Kyagara/equinox/v2/ratelimit"
+)
+
+type RecorderMode string
+
+const (
+	// Sends requests to the base http.RoundTripper, recording the responses.
+	RecordMode RecorderMode = "Record"
+	// Serves recorded responses without sending any request.
+	ReplayMode RecorderMode = "Replay"
+
+	// Replaces the API key and access tokens in cassettes.
+	REDACTED = "REDACTED"
+)
+
+// Returned in ReplayMode when no interaction matches the request.
+var ErrNoInteraction = errors.New("no recorded interaction")
+
+// Headers saved in cassettes, rate limit headers are needed to replay the rate limiter's behavior.
+var recordedHeaders = []string{
+	"Content-Type",
+	ratelimit.APP_RATE_LIMIT_HEADER,
+	ratelimit.APP_RATE_LIMIT_COUNT_HEADER,
+	ratelimit.METHOD_RATE_LIMIT_HEADER,
+	ratelimit.METHOD_RATE_LIMIT_COUNT_HEADER,
+	ratelimit.RATE_LIMIT_TYPE_HEADER,
+	ratelimit.RETRY_AFTER_HEADER,
+}
+
+// File containing the recorded interactions, in the order they were recorded.
+type Cassette struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+type Interaction struct {
+	Method     string      `json:"method"`
+	URL        string      `json:"url"`
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header,omitempty"`
+	// Saved as is if the body is JSON, as a string otherwise.
+	Body jsontext.Value `json:"body,omitempty"`
+}
+
+// http.RoundTripper that records responses to a cassette file or replays them, use it with equinox.NewCustomClient:
+//
+//	recorder, err := equinoxtest.NewRecorder(equinoxtest.ReplayMode, "testdata/cassette.json", nil)
+//	client, err := equinox.NewCustomClient(config, recorder.HTTPClient(), nil, equinox.DefaultRateLimit())
+//
+// In ReplayMode, requests are matched on method and URL, repeated requests are served in the order they were recorded, repeating the last one.
+type Recorder struct {
+	mode     RecorderMode
+	path     string
+	base     http.RoundTripper
+	cassette Cassette
+	// Number of times each method and URL was replayed.
+	replayed map[string]int
+	mutex    sync.Mutex
+}
+
+// Creates a new Recorder for the cassette at path, base is only used in RecordMode and defaults to http.DefaultTransport.
+//
+// In ReplayMode, the cassette is loaded immediately. In RecordMode, Save must be called to write the cassette.
+func NewRecorder(mode RecorderMode, path string, base http.RoundTripper) (*Recorder, error) {
+	if base == nil {
+		base = http.DefaultTransport
+	}
+
+	r := &Recorder{mode: mode, path: path, base: base, replayed: make(map[string]int)}
+
+	switch mode {
+	case RecordMode:
+	case ReplayMode:
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		if err := jsonv2.Unmarshal(data, &r.cassette); err != nil {
+			return nil, fmt.Errorf("error decoding cassette %s: %w", path, err)
+		}
+	default:
+		return nil, fmt.Errorf("unknown recorder mode: %q", mode)
+	}
+
+	return r, nil
+}
+
+func (r *Recorder) Mode() RecorderMode {
+	return r.mode
+}
+
+// Returns an http.Client using the Recorder as its transport.
+func (r *Recorder) HTTPClient() *http.Client {
+	return &http.Client{Transport: r}
+}
+
+// Returns a copy of the interactions recorded or loaded.
+func (r *Recorder) Interactions() []Interaction {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return append([]Interaction(nil), r.cassette.Interactions...)
+}
+
+// Writes the cassette to the path given in NewRecorder, creating missing directories. Does nothing in ReplayMode.
+func (r *Recorder) Save() error {
+	if r.mode != RecordMode {
+		return nil
+	}
+
+	r.mutex.Lock()
+	data, err := jsonv2.Marshal(r.cassette, jsontext.Multiline(true), jsontext.WithIndent("\t"))
+	r.mutex.Unlock()
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(r.path), 0o755); err != nil {
+		return err
+	}
+	return os.WriteFile(r.path, append(data, '\n'), 0o644)
+}
+
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	if r.mode == ReplayMode {
+		return r.replay(req)
+	}
+	return r.record(req)
+}
+
+func (r *Recorder) record(req *http.Request) (*http.Response, error) {
+	response, err := r.base.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+
+	body, err := io.ReadAll(response.Body)
+	response.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	response.Body = io.NopCloser(bytes.NewReader(body))
+
+	secrets := requestSecrets(req)
+	interaction := Interaction{
+		Method:     req.Method,
+		URL:        redact(req.URL.String(), secrets),
+		StatusCode: response.StatusCode,
+		Header:     make(http.Header),
+	}
+
+	for _, name := range recordedHeaders {
+		for _, value := range response.Header.Values(name) {
+			interaction.Header.Add(name, redact(value, secrets))
+		}
+	}
+
+	redacted := []byte(redact(string(body), secrets))
+	switch {
+	case len(redacted) == 0:
+	case jsontext.Value(redacted).IsValid():
+		interaction.Body = redacted
+	default:
+		interaction.Body, err = jsonv2.Marshal(string(redacted))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	r.mutex.Lock()
+	r.cassette.Interactions = append(r.cassette.Interactions, interaction)
+	r.mutex.Unlock()
+	return response, nil
+}
+
+func (r *Recorder) replay(req *http.Request) (*http.Response, error) {
+	url := redact(req.URL.String(), requestSecrets(req))
+	key := req.Method + " " + url
+
+	r.mutex.Lock()
+	var matches []Interaction
+	for _, interaction := range r.cassette.Interactions {
+		if interaction.Method == req.Method && interaction.URL == url {
+			matches = append(matches, interaction)
+		}
+	}
+	if len(matches) == 0 {
+		r.mutex.Unlock()
+		return nil, fmt.Errorf("%w: %s", ErrNoInteraction, key)
+	}
+	i := min(r.replayed[key], len(matches)-1)
+	r.replayed[key]++
+	r.mutex.Unlock()
+
+	interaction := matches[i]
+	body := []byte(interaction.Body)
+	if interaction.Body.Kind() == '"' {
+		var s string
+		if err := jsonv2.Unmarshal(interaction.Body, &s); err != nil {
+			return nil, err
+		}
+		body = []byte(s)
+	}
+
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", interaction.StatusCode, http.StatusText(interaction.StatusCode)),
+		StatusCode:    interaction.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        interaction.Header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(body)),
+		ContentLength: int64(len(body)),
+		Request:       req,
+	}, nil
+}
+
+// Returns the API key and access token sent with the request.
+func requestSecrets(req *http.Request) []string {
+	var secrets []string
+	if key := req.Header.Get("X-Riot-Token"); key != "" {
+		secrets = append(secrets, key)
+	}
+	if token := strings.TrimPrefix(req.Header.Get("Authorization"), "Bearer "); token != "" {
+		secrets = append(secrets, token)
+	}
+	if key := req.URL.Query().Get("api_key"); key != "" {
+		secrets = append(secrets, key)
+	}
+	return secrets
+}
+
+func redact(s string, secrets []string) string {
+	for _, secret := range secrets {
+		s = strings.ReplaceAll(s, secret, REDACTED)
+	}
+	return s
+}
@@ -0,0 +1,106 @@
+package equinoxtest_test
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/Kyagara/equinox/v2"
+	"github.com/Kyagara/equinox/v2/api"
+	"github.com/Kyagara/equinox/v2/equinoxtest"
+	"github.com/Kyagara/equinox/v2/ratelimit"
+	"github.com/Kyagara/equinox/v2/test/util"
+)
+
+func TestRecorder(t *testing.T) {
+	server := equinoxtest.NewServer(nil)
+	require.NoError(t, server.HandleFile("match-v5.getMatch", nil, "../test/data/match-v5.getMatch.json"))
+	server.Script("match-v5.getMatchIdsByPUUID", equinoxtest.Response{StatusCode: http.StatusNotFound, Body: []byte("not found")})
+
+	path := filepath.Join(t.TempDir(), "testdata", "cassette.json")
+	recorder, err := equinoxtest.NewRecorder(equinoxtest.RecordMode, path, server.HTTPClient().Transport)
+	require.NoError(t, err)
+
+	config := util.NewTestEquinoxConfig()
+	client, err := equinox.NewCustomClient(config, recorder.HTTPClient(), nil, ratelimit.NewInternalRateLimit(0.99, 0))
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	match, err := client.LOL.MatchV5.ByID(ctx, api.ASIA, MATCH_ID)
+	require.NoError(t, err)
+	require.Equal(t, MATCH_ID, match.Metadata.MatchID)
+
+	_, err = client.LOL.MatchV5.ListByPUUID(ctx, api.ASIA, PUUID, -1, -1, -1, "", -1, -1)
+	require.ErrorIs(t, err, api.ErrNotFound)
+
+	require.NoError(t, recorder.Save())
+	server.Close()
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.NotContains(t, string(data), config.Key)
+
+	interactions := recorder.Interactions()
+	require.Len(t, interactions, 2)
+	require.Equal(t, "https://asia.api.riotgames.com/lol/match/v5/matches/KR_7014499581", interactions[0].URL)
+	require.Equal(t, "20:1,100:120", interactions[0].Header.Get(ratelimit.APP_RATE_LIMIT_HEADER))
+	require.Equal(t, `"not found"`, string(interactions[1].Body))
+
+	// Replaying works without the server
+	recorder, err = equinoxtest.NewRecorder(equinoxtest.ReplayMode, path, nil)
+	require.NoError(t, err)
+
+	client, err = equinox.NewCustomClient(config, recorder.HTTPClient(), nil, ratelimit.NewInternalRateLimit(0.99, 0))
+	require.NoError(t, err)
+
+	for range 2 {
+		match, err = client.LOL.MatchV5.ByID(ctx, api.ASIA, MATCH_ID)
+		require.NoError(t, err)
+		require.Equal(t, MATCH_ID, match.Metadata.MatchID)
+	}
+
+	_, err = client.LOL.MatchV5.ListByPUUID(ctx, api.ASIA, PUUID, -1, -1, -1, "", -1, -1)
+	require.ErrorIs(t, err, api.ErrNotFound)
+
+	_, err = client.LOL.MatchV5.ByID(ctx, api.ASIA, "KR_1")
+	require.ErrorIs(t, err, equinoxtest.ErrNoInteraction)
+
+	_, err = equinoxtest.NewRecorder("Unknown", path, nil)
+	require.Error(t, err)
+}
+
+func TestRecorderMultiValueHeader(t *testing.T) {
+	transport := httpmock.NewMockTransport()
+	transport.RegisterResponder("GET", "https://asia.api.riotgames.com/lol/match/v5/matches/"+MATCH_ID,
+		httpmock.NewStringResponder(200, `{"metadata":{"matchId":"KR_7014499581"}}`).HeaderAdd(http.Header{
+			ratelimit.APP_RATE_LIMIT_HEADER: {"20:1", "100:120"},
+		}))
+
+	path := filepath.Join(t.TempDir(), "cassette.json")
+	recorder, err := equinoxtest.NewRecorder(equinoxtest.RecordMode, path, transport)
+	require.NoError(t, err)
+
+	config := util.NewTestEquinoxConfig()
+	client, err := equinox.NewCustomClient(config, recorder.HTTPClient(), nil, nil)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	_, err = client.LOL.MatchV5.ByID(ctx, api.ASIA, MATCH_ID)
+	require.NoError(t, err)
+	require.NoError(t, recorder.Save())
+
+	// Every value is kept when saving and replaying
+	recorder, err = equinoxtest.NewRecorder(equinoxtest.ReplayMode, path, nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{"20:1", "100:120"}, recorder.Interactions()[0].Header.Values(ratelimit.APP_RATE_LIMIT_HEADER))
+
+	res, err := recorder.HTTPClient().Get("https://asia.api.riotgames.com/lol/match/v5/matches/" + MATCH_ID)
+	require.NoError(t, err)
+	defer res.Body.Close()
+	require.Equal(t, []string{"20:1", "100:120"}, res.Header.Values(ratelimit.APP_RATE_LIMIT_HEADER))
+}
@@ -0,0 +1,79 @@
+package equinoxtest
+
+///////////////////////////////////////////////
+//                                           //
+//                     !                     //
+//   This file is automatically generated!   //
+//           Do not directly edit!           //
+//                                           //
+///////////////////////////////////////////////
+
+// Spec version = c5f59a3e27f5101b78b8c7eb9b3fb88318b4225d
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Kyagara/equinox/v2/api"
+	"github.com/Kyagara/equinox/v2/clients/riot"
+)
+
+// Fake of riot.ClientAPI.
+type Riot struct {
+	AccountV1 RiotAccountV1
+}
+
+func (f *Riot) AccountV1API() riot.AccountV1API {
+	return &f.AccountV1
+}
+
+// Fake of riot.AccountV1API, methods call the function field of the same name and return ErrNotStubbed if it is nil.
+//
+// Deprecated methods call their WithOptions variant.
+type RiotAccountV1 struct {
+	ActiveRegionFunc  func(ctx context.Context, route api.RegionalRoute, game string, puuid string) (*riot.AccountRegionV1DTO, error)
+	ActiveShardFunc   func(ctx context.Context, route api.RegionalRoute, game string, puuid string) (*riot.AccountActiveShardV1DTO, error)
+	ByAccessTokenFunc func(ctx context.Context, route api.RegionalRoute, accessToken string) (*riot.AccountV1DTO, error)
+	ByPUUIDFunc       func(ctx context.Context, route api.RegionalRoute, puuid string) (*riot.AccountV1DTO, error)
+	ByRiotIDFunc      func(ctx context.Context, route api.RegionalRoute, gameName string, tagLine string) (*riot.AccountV1DTO, error)
+}
+
+func (f *RiotAccountV1) ActiveRegion(ctx context.Context, route api.RegionalRoute, game string, puuid string) (*riot.AccountRegionV1DTO, error) {
+	if f.ActiveRegionFunc == nil {
+		err := fmt.Errorf("%w: RiotAccountV1.ActiveRegion", ErrNotStubbed)
+		return nil, err
+	}
+	return f.ActiveRegionFunc(ctx, route, game, puuid)
+}
+
+func (f *RiotAccountV1) ActiveShard(ctx context.Context, route api.RegionalRoute, game string, puuid string) (*riot.AccountActiveShardV1DTO, error) {
+	if f.ActiveShardFunc == nil {
+		err := fmt.Errorf("%w: RiotAccountV1.ActiveShard", ErrNotStubbed)
+		return nil, err
+	}
+	return f.ActiveShardFunc(ctx, route, game, puuid)
+}
+
+func (f *RiotAccountV1) ByAccessToken(ctx context.Context, route api.RegionalRoute, accessToken string) (*riot.AccountV1DTO, error) {
+	if f.ByAccessTokenFunc == nil {
+		err := fmt.Errorf("%w: RiotAccountV1.ByAccessToken", ErrNotStubbed)
+		return nil, err
+	}
+	return f.ByAccessTokenFunc(ctx, route, accessToken)
+}
+
+func (f *RiotAccountV1) ByPUUID(ctx context.Context, route api.RegionalRoute, puuid string) (*riot.AccountV1DTO, error) {
+	if f.ByPUUIDFunc == nil {
+		err := fmt.Errorf("%w: RiotAccountV1.ByPUUID", ErrNotStubbed)
+		return nil, err
+	}
+	return f.ByPUUIDFunc(ctx, route, puuid)
+}
+
+func (f *RiotAccountV1) ByRiotID(ctx context.Context, route api.RegionalRoute, gameName string, tagLine string) (*riot.AccountV1DTO, error) {
+	if f.ByRiotIDFunc == nil {
+		err := fmt.Errorf("%w: RiotAccountV1.ByRiotID", ErrNotStubbed)
+		return nil, err
+	}
+	return f.ByRiotIDFunc(ctx, route, gameName, tagLine)
+}
@@ -0,0 +1,429 @@
+package equinoxtest
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	jsonv2 "github.com/go-json-experiment/json"
+
+	"github.com/Kyagara/equinox/v2/api"
+	"github.com/Kyagara/equinox/v2/ratelimit"
+)
+
+const (
+	// Development API key application limits.
+	DEFAULT_APP_LIMIT    = "20:1,100:120"
+	DEFAULT_METHOD_LIMIT = "2000:60"
+)
+
+var pathParamRegex = regexp.MustCompile(`\{(\w+)\}`)
+
+// Options for NewServer.
+type ServerOptions struct {
+	// Application limits advertised and enforced per route, such as "20:1,100:120". Defaults to DEFAULT_APP_LIMIT.
+	AppLimit string
+	// Method limits keyed by method ID, such as "match-v5.getMatch". Methods not present use DefaultMethodLimit.
+	MethodLimits map[string]string
+	// Defaults to DEFAULT_METHOD_LIMIT.
+	DefaultMethodLimit string
+}
+
+// A scripted response, see Server.Script.
+type Response struct {
+	// JSON body, []byte is written as is, anything else is marshalled.
+	Body       any
+	StatusCode int
+	// Sent as the 'Retry-After' header, in seconds, if positive.
+	RetryAfter int
+	// Sent as the 'X-Rate-Limit-Type' header, such as "application", "method" or "service".
+	LimitType string
+}
+
+// Fake of the Riot API, serving fixtures for the endpoints in api.AllEndpoints.
+//
+// Requests must be sent with the http.Client returned by HTTPClient, which redirects every '*.api.riotgames.com' request to the server.
+type Server struct {
+	server    *httptest.Server
+	opts      ServerOptions
+	endpoints []endpoint
+	fixtures  map[string][]fixture
+	scripts   map[string][]Response
+	requests  map[string][]int
+	limiters  map[string]*limiter
+	mutex     sync.Mutex
+}
+
+type endpoint struct {
+	pattern  *regexp.Regexp
+	method   string
+	methodID string
+	params   []string
+	literals int
+}
+
+type fixture struct {
+	params map[string]string
+	body   []byte
+}
+
+// Starts a new Server, opts can be nil. The server must be closed with Close.
+func NewServer(opts *ServerOptions) *Server {
+	s := &Server{
+		fixtures: make(map[string][]fixture),
+		scripts:  make(map[string][]Response),
+		requests: make(map[string][]int),
+		limiters: make(map[string]*limiter),
+	}
+
+	if opts != nil {
+		s.opts = *opts
+	}
+	if s.opts.AppLimit == "" {
+		s.opts.AppLimit = DEFAULT_APP_LIMIT
+	}
+	if s.opts.DefaultMethodLimit == "" {
+		s.opts.DefaultMethodLimit = DEFAULT_METHOD_LIMIT
+	}
+
+	for _, e := range api.AllEndpoints {
+		s.endpoints = append(s.endpoints, newEndpoint(e[0], e[1], e[2]))
+	}
+
+	// Paths with more literal segments first, '/entries/by-puuid/{puuid}' before '/entries/{tier}/{division}'
+	slices.SortStableFunc(s.endpoints, func(a, b endpoint) int {
+		return b.literals - a.literals
+	})
+
+	s.server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+func newEndpoint(method string, path string, methodID string) endpoint {
+	e := endpoint{method: method, methodID: methodID}
+	segments := strings.Split(path, "/")
+	for i, segment := range segments {
+		if match := pathParamRegex.FindStringSubmatch(segment); match != nil {
+			e.params = append(e.params, match[1])
+			segments[i] = "([^/]+)"
+			continue
+		}
+		if segment != "" {
+			e.literals++
+		}
+		segments[i] = regexp.QuoteMeta(segment)
+	}
+	e.pattern = regexp.MustCompile("^" + strings.Join(segments, "/") + "$")
+	return e
+}
+
+// URL of the server, such as 'http://127.0.0.1:12345'.
+func (s *Server) URL() string {
+	return s.server.URL
+}
+
+func (s *Server) Close() {
+	s.server.Close()
+}
+
+// Returns an http.Client that sends every request to the server, keeping the original host to identify the route.
+func (s *Server) HTTPClient() *http.Client {
+	target, _ := url.Parse(s.server.URL)
+	return &http.Client{Transport: rewriteTransport{target: target, base: s.server.Client().Transport}}
+}
+
+// Serves body for the method ID, such as "match-v5.getMatch", when the path parameters match params.
+//
+// nil params matches any request to the method, body can be []byte or any value that will be marshalled.
+func (s *Server) Handle(methodID string, params map[string]string, body any) error {
+	data, err := marshalBody(body)
+	if err != nil {
+		return err
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.fixtures[methodID] = append(s.fixtures[methodID], fixture{params: params, body: data})
+	return nil
+}
+
+// Same as Handle, reading the body from a file.
+func (s *Server) HandleFile(methodID string, params map[string]string, path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	return s.Handle(methodID, params, data)
+}
+
+// Serves the fixtures in a directory, such as the repository's 'test/data', for any path parameters.
+//
+// Fixtures are named after the method ID, such as 'match-v5.getMatch.json'. Returns an error for a method ID not in api.AllEndpoints.
+func (s *Server) LoadFixtures(dir string) error {
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return err
+	}
+
+	for _, path := range files {
+		methodID := strings.TrimSuffix(filepath.Base(path), ".json")
+		if !slices.ContainsFunc(s.endpoints, func(e endpoint) bool { return e.methodID == methodID }) {
+			return fmt.Errorf("unknown method ID in fixture '%s'", path)
+		}
+		err := s.HandleFile(methodID, nil, path)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Queues responses for the method ID, they are returned in order, one per request, before any fixture and without counting towards the limits.
+func (s *Server) Script(methodID string, responses ...Response) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.scripts[methodID] = append(s.scripts[methodID], responses...)
+}
+
+// Returns the status codes of the responses sent for the method ID, in order.
+func (s *Server) Requests(methodID string) []int {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return slices.Clone(s.requests[methodID])
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	route := strings.TrimSuffix(r.Host, api.RIOT_API_BASE_URL_FORMAT)
+
+	e, params, ok := s.match(r.Method, r.URL.Path)
+	if !ok {
+		writeError(w, http.StatusNotFound, "Resource not found")
+		return
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	status := s.respond(w, r, route, e.methodID, params)
+	s.requests[e.methodID] = append(s.requests[e.methodID], status)
+}
+
+// Writes the response and returns its status code, called with the mutex locked.
+func (s *Server) respond(w http.ResponseWriter, r *http.Request, route string, methodID string, params map[string]string) int {
+	if r.Header.Get("X-Riot-Token") == "" && r.Header.Get("Authorization") == "" {
+		return writeError(w, http.StatusUnauthorized, "Unauthorized")
+	}
+
+	app := s.limiter(route, ratelimit.APP_RATE_LIMIT_TYPE, s.opts.AppLimit)
+	methodLimit, ok := s.opts.MethodLimits[methodID]
+	if !ok {
+		methodLimit = s.opts.DefaultMethodLimit
+	}
+	method := s.limiter(route, methodID, methodLimit)
+
+	if scripts := s.scripts[methodID]; len(scripts) > 0 {
+		response := scripts[0]
+		s.scripts[methodID] = scripts[1:]
+
+		now := time.Now()
+		setLimitHeaders(w, app, method, now)
+		if response.RetryAfter > 0 {
+			w.Header().Set(ratelimit.RETRY_AFTER_HEADER, strconv.Itoa(response.RetryAfter))
+		}
+		if response.LimitType != "" {
+			w.Header().Set(ratelimit.RATE_LIMIT_TYPE_HEADER, response.LimitType)
+		}
+
+		body, err := marshalBody(response.Body)
+		if err != nil {
+			return writeError(w, http.StatusInternalServerError, err.Error())
+		}
+		return writeBody(w, response.StatusCode, body)
+	}
+
+	now := time.Now()
+	for _, l := range []*limiter{app, method} {
+		if wait := l.exceeded(now); wait > 0 {
+			setLimitHeaders(w, app, method, now)
+			w.Header().Set(ratelimit.RETRY_AFTER_HEADER, strconv.Itoa(int((wait+time.Second-1)/time.Second)))
+			w.Header().Set(ratelimit.RATE_LIMIT_TYPE_HEADER, l.limitType)
+			return writeError(w, http.StatusTooManyRequests, "Rate limit exceeded")
+		}
+	}
+
+	app.take(now)
+	method.take(now)
+	setLimitHeaders(w, app, method, now)
+
+	for _, f := range s.fixtures[methodID] {
+		if paramsMatch(f.params, params) {
+			return writeBody(w, http.StatusOK, f.body)
+		}
+	}
+
+	return writeError(w, http.StatusNotFound, "Data not found")
+}
+
+func (s *Server) match(method string, path string) (endpoint, map[string]string, bool) {
+	for _, e := range s.endpoints {
+		if e.method != method {
+			continue
+		}
+
+		values := e.pattern.FindStringSubmatch(path)
+		if values == nil {
+			continue
+		}
+
+		params := make(map[string]string, len(e.params))
+		for i, name := range e.params {
+			value, err := url.PathUnescape(values[i+1])
+			if err != nil {
+				value = values[i+1]
+			}
+			params[name] = value
+		}
+		return e, params, true
+	}
+	return endpoint{}, nil, false
+}
+
+// Returns the limiter for the route and key, called with the mutex locked.
+func (s *Server) limiter(route string, key string, limits string) *limiter {
+	id := route + "|" + key
+	if l, ok := s.limiters[id]; ok {
+		return l
+	}
+
+	limitType := ratelimit.METHOD_RATE_LIMIT_TYPE
+	if key == ratelimit.APP_RATE_LIMIT_TYPE {
+		limitType = ratelimit.APP_RATE_LIMIT_TYPE
+	}
+
+	l := newLimiter(limitType, limits)
+	s.limiters[id] = l
+	return l
+}
+
+func paramsMatch(want map[string]string, got map[string]string) bool {
+	for key, value := range want {
+		if got[key] != value {
+			return false
+		}
+	}
+	return true
+}
+
+func setLimitHeaders(w http.ResponseWriter, app *limiter, method *limiter, now time.Time) {
+	w.Header().Set(ratelimit.APP_RATE_LIMIT_HEADER, app.limits)
+	w.Header().Set(ratelimit.APP_RATE_LIMIT_COUNT_HEADER, app.counts(now))
+	w.Header().Set(ratelimit.METHOD_RATE_LIMIT_HEADER, method.limits)
+	w.Header().Set(ratelimit.METHOD_RATE_LIMIT_COUNT_HEADER, method.counts(now))
+}
+
+func marshalBody(body any) ([]byte, error) {
+	switch b := body.(type) {
+	case nil:
+		return nil, nil
+	case []byte:
+		return b, nil
+	default:
+		return jsonv2.Marshal(body)
+	}
+}
+
+func writeBody(w http.ResponseWriter, status int, body []byte) int {
+	if body != nil {
+		w.Header().Set("Content-Type", "application/json;charset=utf-8")
+	}
+	w.WriteHeader(status)
+	_, _ = w.Write(body)
+	return status
+}
+
+// Writes an error body in the same format as the Riot API.
+func writeError(w http.ResponseWriter, status int, message string) int {
+	body := fmt.Sprintf(`{"status":{"message":%q,"status_code":%d}}`, message, status)
+	return writeBody(w, status, []byte(body))
+}
+
+type rewriteTransport struct {
+	target *url.URL
+	base   http.RoundTripper
+}
+
+func (t rewriteTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.Host = req.URL.Host
+	req.URL.Scheme = t.target.Scheme
+	req.URL.Host = t.target.Host
+	return t.base.RoundTrip(req)
+}
+
+// Fixed window counters for the limits in a 'X-App-Rate-Limit' or 'X-Method-Rate-Limit' header.
+type limiter struct {
+	limitType string
+	limits    string
+	windows   []*window
+}
+
+type window struct {
+	start    time.Time
+	limit    int
+	interval time.Duration
+	count    int
+}
+
+func newLimiter(limitType string, limits string) *limiter {
+	l := &limiter{limitType: limitType, limits: limits}
+	for _, pair := range strings.Split(limits, ",") {
+		limit, interval := ratelimit.GetNumbersFromPair(pair)
+		l.windows = append(l.windows, &window{limit: limit, interval: interval})
+	}
+	return l
+}
+
+// Returns how long until the first exceeded window resets, 0 if none is exceeded.
+func (l *limiter) exceeded(now time.Time) time.Duration {
+	var wait time.Duration
+	for _, w := range l.windows {
+		w.reset(now)
+		if w.count >= w.limit {
+			wait = max(wait, w.start.Add(w.interval).Sub(now))
+		}
+	}
+	return wait
+}
+
+func (l *limiter) take(now time.Time) {
+	for _, w := range l.windows {
+		w.reset(now)
+		w.count++
+	}
+}
+
+// Returns the value of the count header, such as '1:1,1:120'.
+func (l *limiter) counts(now time.Time) string {
+	counts := make([]string, len(l.windows))
+	for i, w := range l.windows {
+		w.reset(now)
+		counts[i] = strconv.Itoa(w.count) + ":" + strconv.Itoa(int(w.interval.Seconds()))
+	}
+	return strings.Join(counts, ",")
+}
+
+func (w *window) reset(now time.Time) {
+	if w.start.IsZero() || !now.Before(w.start.Add(w.interval)) {
+		w.start = now
+		w.count = 0
+	}
+}
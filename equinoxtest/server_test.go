@@ -0,0 +1,134 @@
+package equinoxtest_test
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/Kyagara/equinox/v2"
+	"github.com/Kyagara/equinox/v2/api"
+	"github.com/Kyagara/equinox/v2/clients/lol"
+	"github.com/Kyagara/equinox/v2/equinoxtest"
+	"github.com/Kyagara/equinox/v2/ratelimit"
+	"github.com/Kyagara/equinox/v2/test/util"
+)
+
+const (
+	MATCH_ID = "KR_7014499581"
+	PUUID    = "VauDEPojllHnNohV9rSK5I_jn0cqqlxWVL6_h2M5zw8fzXFwA_wzr6elF4BPTquHvannIALRRnuLaA"
+)
+
+func newServerClient(t *testing.T, server *equinoxtest.Server, retries int, rateLimit *ratelimit.RateLimit) *equinox.Equinox {
+	config := util.NewTestEquinoxConfig()
+	config.Retry = api.Retry{MaxRetries: retries}
+	client, err := equinox.NewCustomClient(config, server.HTTPClient(), nil, rateLimit)
+	require.NoError(t, err)
+	return client
+}
+
+func TestServer(t *testing.T) {
+	server := equinoxtest.NewServer(nil)
+	defer server.Close()
+
+	require.NoError(t, server.Handle("match-v5.getMatch", map[string]string{"matchId": "BR1_1"}, map[string]any{"metadata": map[string]string{"matchId": "BR1_1"}}))
+
+	client := newServerClient(t, server, 0, nil)
+	ctx := context.Background()
+
+	match, err := client.LOL.MatchV5.ByID(ctx, api.AMERICAS, "BR1_1")
+	require.NoError(t, err)
+	require.Equal(t, "BR1_1", match.Metadata.MatchID)
+
+	_, err = client.LOL.MatchV5.ByID(ctx, api.AMERICAS, "BR1_2")
+	require.ErrorIs(t, err, api.ErrNotFound)
+	require.Equal(t, []int{http.StatusOK, http.StatusNotFound}, server.Requests("match-v5.getMatch"))
+
+	// Fixtures without parameters match any request
+	require.NoError(t, server.LoadFixtures("../test/data"))
+
+	match, err = client.LOL.MatchV5.ByID(ctx, api.ASIA, MATCH_ID)
+	require.NoError(t, err)
+	require.Equal(t, MATCH_ID, match.Metadata.MatchID)
+
+	summoner, err := client.LOL.SummonerV4.ByPUUID(ctx, lol.KR, PUUID)
+	require.NoError(t, err)
+	require.Equal(t, PUUID, summoner.PUUID)
+
+	// Fixtures are named after the method ID
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "match-v5.getMatch.json"), []byte(`{}`), 0o644))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, "match.json"), []byte(`{}`), 0o644))
+	require.ErrorContains(t, server.LoadFixtures(dir), "unknown method ID")
+
+	// Requests without an API key are rejected
+	response, err := server.HTTPClient().Get("https://americas.api.riotgames.com/lol/match/v5/matches/BR1_1")
+	require.NoError(t, err)
+	defer response.Body.Close()
+	require.Equal(t, http.StatusUnauthorized, response.StatusCode)
+}
+
+func TestServerScript(t *testing.T) {
+	server := equinoxtest.NewServer(nil)
+	defer server.Close()
+
+	require.NoError(t, server.HandleFile("match-v5.getMatch", nil, "../test/data/match-v5.getMatch.json"))
+	server.Script("match-v5.getMatch",
+		equinoxtest.Response{StatusCode: http.StatusServiceUnavailable},
+		equinoxtest.Response{StatusCode: http.StatusTooManyRequests, RetryAfter: 1, LimitType: ratelimit.SERVICE_RATE_LIMIT_TYPE},
+	)
+
+	ctx := context.Background()
+
+	// Without retries the scripted response is returned
+	client := newServerClient(t, server, 0, nil)
+	_, err := client.LOL.MatchV5.ByID(ctx, api.ASIA, MATCH_ID)
+	require.ErrorIs(t, err, api.ErrServiceUnavailable)
+
+	// The 429 is retried, the fixture is served afterwards
+	client = newServerClient(t, server, 1, ratelimit.NewInternalRateLimit(0.99, 0))
+	match, err := client.LOL.MatchV5.ByID(ctx, api.ASIA, MATCH_ID)
+	require.NoError(t, err)
+	require.Equal(t, MATCH_ID, match.Metadata.MatchID)
+	require.Equal(t, []int{http.StatusServiceUnavailable, http.StatusTooManyRequests, http.StatusOK}, server.Requests("match-v5.getMatch"))
+}
+
+func TestServerLimits(t *testing.T) {
+	server := equinoxtest.NewServer(&equinoxtest.ServerOptions{
+		AppLimit:     "3:1",
+		MethodLimits: map[string]string{"match-v5.getMatch": "2:10"},
+	})
+	defer server.Close()
+
+	require.NoError(t, server.HandleFile("match-v5.getMatch", nil, "../test/data/match-v5.getMatch.json"))
+	require.NoError(t, server.HandleFile("summoner-v4.getByPUUID", nil, "../test/data/summoner-v4.getByPUUID.json"))
+
+	ctx := context.Background()
+
+	// The method limit is enforced without a rate limiter
+	client := newServerClient(t, server, 0, nil)
+	for range 2 {
+		_, err := client.LOL.MatchV5.ByID(ctx, api.ASIA, MATCH_ID)
+		require.NoError(t, err)
+	}
+	_, err := client.LOL.MatchV5.ByID(ctx, api.ASIA, MATCH_ID)
+	require.ErrorIs(t, err, api.ErrTooManyRequests)
+
+	// Limits are per route, the rate limiter learns them from the headers and avoids any 429
+	var rateLimited int
+	rateLimit := ratelimit.NewInternalRateLimit(1, 0)
+	rateLimit.Listeners = &api.Listeners{
+		OnRateLimited: func(event api.RateLimitedEvent) { rateLimited++ },
+	}
+	client = newServerClient(t, server, 0, rateLimit)
+	for range 5 {
+		_, err := client.LOL.SummonerV4.ByPUUID(ctx, lol.KR, PUUID)
+		require.NoError(t, err)
+	}
+
+	require.Positive(t, rateLimited)
+	require.NotContains(t, server.Requests("summoner-v4.getByPUUID"), http.StatusTooManyRequests)
+}
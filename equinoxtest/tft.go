@@ -0,0 +1,256 @@
+package equinoxtest
+
+///////////////////////////////////////////////
+//                                           //
+//                     !                     //
+//   This file is automatically generated!   //
+//           Do not directly edit!           //
+//                                           //
+///////////////////////////////////////////////
+
+// Spec version = c5f59a3e27f5101b78b8c7eb9b3fb88318b4225d
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Kyagara/equinox/v2/api"
+	"github.com/Kyagara/equinox/v2/clients/tft"
+)
+
+// Fake of tft.ClientAPI.
+type TFT struct {
+	SpectatorV5 TFTSpectatorV5
+	LeagueV1    TFTLeagueV1
+	MatchV1     TFTMatchV1
+	StatusV1    TFTStatusV1
+	SummonerV1  TFTSummonerV1
+}
+
+func (f *TFT) SpectatorV5API() tft.SpectatorV5API {
+	return &f.SpectatorV5
+}
+
+func (f *TFT) LeagueV1API() tft.LeagueV1API {
+	return &f.LeagueV1
+}
+
+func (f *TFT) MatchV1API() tft.MatchV1API {
+	return &f.MatchV1
+}
+
+func (f *TFT) StatusV1API() tft.StatusV1API {
+	return &f.StatusV1
+}
+
+func (f *TFT) SummonerV1API() tft.SummonerV1API {
+	return &f.SummonerV1
+}
+
+// Fake of tft.LeagueV1API, methods call the function field of the same name and return ErrNotStubbed if it is nil.
+//
+// Deprecated methods call their WithOptions variant.
+type TFTLeagueV1 struct {
+	ByIDFunc                          func(ctx context.Context, route tft.PlatformRoute, leagueId string) (*tft.LeagueListV1DTO, error)
+	ChallengerByQueueWithOptionsFunc  func(ctx context.Context, route tft.PlatformRoute, opts *tft.LeagueV1ChallengerByQueueOptions) (*tft.LeagueListV1DTO, error)
+	EntriesWithOptionsFunc            func(ctx context.Context, route tft.PlatformRoute, tier tft.Tier, division string, opts *tft.LeagueV1EntriesOptions) ([]tft.LeagueEntryV1DTO, error)
+	EntriesByPUUIDFunc                func(ctx context.Context, route tft.PlatformRoute, puuid string) ([]tft.LeagueEntryV1DTO, error)
+	GrandmasterByQueueWithOptionsFunc func(ctx context.Context, route tft.PlatformRoute, opts *tft.LeagueV1GrandmasterByQueueOptions) (*tft.LeagueListV1DTO, error)
+	MasterByQueueWithOptionsFunc      func(ctx context.Context, route tft.PlatformRoute, opts *tft.LeagueV1MasterByQueueOptions) (*tft.LeagueListV1DTO, error)
+	TopRatedLadderFunc                func(ctx context.Context, route tft.PlatformRoute, queue tft.QueueType) ([]tft.LeagueTopRatedLadderEntryV1DTO, error)
+}
+
+func (f *TFTLeagueV1) ByID(ctx context.Context, route tft.PlatformRoute, leagueId string) (*tft.LeagueListV1DTO, error) {
+	if f.ByIDFunc == nil {
+		err := fmt.Errorf("%w: TFTLeagueV1.ByID", ErrNotStubbed)
+		return nil, err
+	}
+	return f.ByIDFunc(ctx, route, leagueId)
+}
+
+func (f *TFTLeagueV1) ChallengerByQueue(ctx context.Context, route tft.PlatformRoute, queue string) (*tft.LeagueListV1DTO, error) {
+	opts := &tft.LeagueV1ChallengerByQueueOptions{}
+	if queue != "" {
+		opts.Queue = &queue
+	}
+	return f.ChallengerByQueueWithOptions(ctx, route, opts)
+}
+
+func (f *TFTLeagueV1) ChallengerByQueueWithOptions(ctx context.Context, route tft.PlatformRoute, opts *tft.LeagueV1ChallengerByQueueOptions) (*tft.LeagueListV1DTO, error) {
+	if f.ChallengerByQueueWithOptionsFunc == nil {
+		err := fmt.Errorf("%w: TFTLeagueV1.ChallengerByQueueWithOptions", ErrNotStubbed)
+		return nil, err
+	}
+	return f.ChallengerByQueueWithOptionsFunc(ctx, route, opts)
+}
+
+func (f *TFTLeagueV1) Entries(ctx context.Context, route tft.PlatformRoute, tier tft.Tier, division string, queue string, page int) ([]tft.LeagueEntryV1DTO, error) {
+	opts := &tft.LeagueV1EntriesOptions{}
+	if queue != "" {
+		opts.Queue = &queue
+	}
+	if page != -1 {
+		opts.Page = &page
+	}
+	return f.EntriesWithOptions(ctx, route, tier, division, opts)
+}
+
+func (f *TFTLeagueV1) EntriesWithOptions(ctx context.Context, route tft.PlatformRoute, tier tft.Tier, division string, opts *tft.LeagueV1EntriesOptions) ([]tft.LeagueEntryV1DTO, error) {
+	if f.EntriesWithOptionsFunc == nil {
+		err := fmt.Errorf("%w: TFTLeagueV1.EntriesWithOptions", ErrNotStubbed)
+		return nil, err
+	}
+	return f.EntriesWithOptionsFunc(ctx, route, tier, division, opts)
+}
+
+func (f *TFTLeagueV1) EntriesByPUUID(ctx context.Context, route tft.PlatformRoute, puuid string) ([]tft.LeagueEntryV1DTO, error) {
+	if f.EntriesByPUUIDFunc == nil {
+		err := fmt.Errorf("%w: TFTLeagueV1.EntriesByPUUID", ErrNotStubbed)
+		return nil, err
+	}
+	return f.EntriesByPUUIDFunc(ctx, route, puuid)
+}
+
+func (f *TFTLeagueV1) GrandmasterByQueue(ctx context.Context, route tft.PlatformRoute, queue string) (*tft.LeagueListV1DTO, error) {
+	opts := &tft.LeagueV1GrandmasterByQueueOptions{}
+	if queue != "" {
+		opts.Queue = &queue
+	}
+	return f.GrandmasterByQueueWithOptions(ctx, route, opts)
+}
+
+func (f *TFTLeagueV1) GrandmasterByQueueWithOptions(ctx context.Context, route tft.PlatformRoute, opts *tft.LeagueV1GrandmasterByQueueOptions) (*tft.LeagueListV1DTO, error) {
+	if f.GrandmasterByQueueWithOptionsFunc == nil {
+		err := fmt.Errorf("%w: TFTLeagueV1.GrandmasterByQueueWithOptions", ErrNotStubbed)
+		return nil, err
+	}
+	return f.GrandmasterByQueueWithOptionsFunc(ctx, route, opts)
+}
+
+func (f *TFTLeagueV1) MasterByQueue(ctx context.Context, route tft.PlatformRoute, queue string) (*tft.LeagueListV1DTO, error) {
+	opts := &tft.LeagueV1MasterByQueueOptions{}
+	if queue != "" {
+		opts.Queue = &queue
+	}
+	return f.MasterByQueueWithOptions(ctx, route, opts)
+}
+
+func (f *TFTLeagueV1) MasterByQueueWithOptions(ctx context.Context, route tft.PlatformRoute, opts *tft.LeagueV1MasterByQueueOptions) (*tft.LeagueListV1DTO, error) {
+	if f.MasterByQueueWithOptionsFunc == nil {
+		err := fmt.Errorf("%w: TFTLeagueV1.MasterByQueueWithOptions", ErrNotStubbed)
+		return nil, err
+	}
+	return f.MasterByQueueWithOptionsFunc(ctx, route, opts)
+}
+
+func (f *TFTLeagueV1) TopRatedLadder(ctx context.Context, route tft.PlatformRoute, queue tft.QueueType) ([]tft.LeagueTopRatedLadderEntryV1DTO, error) {
+	if f.TopRatedLadderFunc == nil {
+		err := fmt.Errorf("%w: TFTLeagueV1.TopRatedLadder", ErrNotStubbed)
+		return nil, err
+	}
+	return f.TopRatedLadderFunc(ctx, route, queue)
+}
+
+// Fake of tft.MatchV1API, methods call the function field of the same name and return ErrNotStubbed if it is nil.
+//
+// Deprecated methods call their WithOptions variant.
+type TFTMatchV1 struct {
+	ByIDFunc                   func(ctx context.Context, route api.RegionalRoute, matchId string) (*tft.MatchV1DTO, error)
+	ListByPUUIDWithOptionsFunc func(ctx context.Context, route api.RegionalRoute, puuid string, opts *tft.MatchV1ListByPUUIDOptions) ([]string, error)
+}
+
+func (f *TFTMatchV1) ByID(ctx context.Context, route api.RegionalRoute, matchId string) (*tft.MatchV1DTO, error) {
+	if f.ByIDFunc == nil {
+		err := fmt.Errorf("%w: TFTMatchV1.ByID", ErrNotStubbed)
+		return nil, err
+	}
+	return f.ByIDFunc(ctx, route, matchId)
+}
+
+func (f *TFTMatchV1) ListByPUUID(ctx context.Context, route api.RegionalRoute, puuid string, start int, endTime int, startTime int, count int) ([]string, error) {
+	opts := &tft.MatchV1ListByPUUIDOptions{}
+	if start != -1 {
+		opts.Start = &start
+	}
+	if endTime != -1 {
+		opts.EndTime = &endTime
+	}
+	if startTime != -1 {
+		opts.StartTime = &startTime
+	}
+	if count != -1 {
+		opts.Count = &count
+	}
+	return f.ListByPUUIDWithOptions(ctx, route, puuid, opts)
+}
+
+func (f *TFTMatchV1) ListByPUUIDWithOptions(ctx context.Context, route api.RegionalRoute, puuid string, opts *tft.MatchV1ListByPUUIDOptions) ([]string, error) {
+	if f.ListByPUUIDWithOptionsFunc == nil {
+		err := fmt.Errorf("%w: TFTMatchV1.ListByPUUIDWithOptions", ErrNotStubbed)
+		return nil, err
+	}
+	return f.ListByPUUIDWithOptionsFunc(ctx, route, puuid, opts)
+}
+
+// Fake of tft.SpectatorV5API, methods call the function field of the same name and return ErrNotStubbed if it is nil.
+//
+// Deprecated methods call their WithOptions variant.
+type TFTSpectatorV5 struct {
+	CurrentGameInfoByPUUIDFunc func(ctx context.Context, route tft.PlatformRoute, encryptedPUUID string) (*tft.SpectatorCurrentGameInfoV5DTO, error)
+	FeaturedFunc               func(ctx context.Context, route tft.PlatformRoute) (*tft.SpectatorFeaturedGamesV5DTO, error)
+}
+
+func (f *TFTSpectatorV5) CurrentGameInfoByPUUID(ctx context.Context, route tft.PlatformRoute, encryptedPUUID string) (*tft.SpectatorCurrentGameInfoV5DTO, error) {
+	if f.CurrentGameInfoByPUUIDFunc == nil {
+		err := fmt.Errorf("%w: TFTSpectatorV5.CurrentGameInfoByPUUID", ErrNotStubbed)
+		return nil, err
+	}
+	return f.CurrentGameInfoByPUUIDFunc(ctx, route, encryptedPUUID)
+}
+
+func (f *TFTSpectatorV5) Featured(ctx context.Context, route tft.PlatformRoute) (*tft.SpectatorFeaturedGamesV5DTO, error) {
+	if f.FeaturedFunc == nil {
+		err := fmt.Errorf("%w: TFTSpectatorV5.Featured", ErrNotStubbed)
+		return nil, err
+	}
+	return f.FeaturedFunc(ctx, route)
+}
+
+// Fake of tft.StatusV1API, methods call the function field of the same name and return ErrNotStubbed if it is nil.
+//
+// Deprecated methods call their WithOptions variant.
+type TFTStatusV1 struct {
+	PlatformFunc func(ctx context.Context, route tft.PlatformRoute) (*tft.StatusPlatformDataV1DTO, error)
+}
+
+func (f *TFTStatusV1) Platform(ctx context.Context, route tft.PlatformRoute) (*tft.StatusPlatformDataV1DTO, error) {
+	if f.PlatformFunc == nil {
+		err := fmt.Errorf("%w: TFTStatusV1.Platform", ErrNotStubbed)
+		return nil, err
+	}
+	return f.PlatformFunc(ctx, route)
+}
+
+// Fake of tft.SummonerV1API, methods call the function field of the same name and return ErrNotStubbed if it is nil.
+//
+// Deprecated methods call their WithOptions variant.
+type TFTSummonerV1 struct {
+	ByAccessTokenFunc func(ctx context.Context, route tft.PlatformRoute, accessToken string) (*tft.SummonerV1DTO, error)
+	ByPUUIDFunc       func(ctx context.Context, route tft.PlatformRoute, encryptedPUUID string) (*tft.SummonerV1DTO, error)
+}
+
+func (f *TFTSummonerV1) ByAccessToken(ctx context.Context, route tft.PlatformRoute, accessToken string) (*tft.SummonerV1DTO, error) {
+	if f.ByAccessTokenFunc == nil {
+		err := fmt.Errorf("%w: TFTSummonerV1.ByAccessToken", ErrNotStubbed)
+		return nil, err
+	}
+	return f.ByAccessTokenFunc(ctx, route, accessToken)
+}
+
+func (f *TFTSummonerV1) ByPUUID(ctx context.Context, route tft.PlatformRoute, encryptedPUUID string) (*tft.SummonerV1DTO, error) {
+	if f.ByPUUIDFunc == nil {
+		err := fmt.Errorf("%w: TFTSummonerV1.ByPUUID", ErrNotStubbed)
+		return nil, err
+	}
+	return f.ByPUUIDFunc(ctx, route, encryptedPUUID)
+}
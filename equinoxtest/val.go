@@ -0,0 +1,208 @@
+package equinoxtest
+
+///////////////////////////////////////////////
+//                                           //
+//                     !                     //
+//   This file is automatically generated!   //
+//           Do not directly edit!           //
+//                                           //
+///////////////////////////////////////////////
+
+// Spec version = c5f59a3e27f5101b78b8c7eb9b3fb88318b4225d
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/Kyagara/equinox/v2/clients/val"
+)
+
+// Fake of val.ClientAPI.
+type VAL struct {
+	ConsoleMatchV1  VALConsoleMatchV1
+	ConsoleRankedV1 VALConsoleRankedV1
+	ContentV1       VALContentV1
+	MatchV1         VALMatchV1
+	RankedV1        VALRankedV1
+	StatusV1        VALStatusV1
+}
+
+func (f *VAL) ConsoleMatchV1API() val.ConsoleMatchV1API {
+	return &f.ConsoleMatchV1
+}
+
+func (f *VAL) ConsoleRankedV1API() val.ConsoleRankedV1API {
+	return &f.ConsoleRankedV1
+}
+
+func (f *VAL) ContentV1API() val.ContentV1API {
+	return &f.ContentV1
+}
+
+func (f *VAL) MatchV1API() val.MatchV1API {
+	return &f.MatchV1
+}
+
+func (f *VAL) RankedV1API() val.RankedV1API {
+	return &f.RankedV1
+}
+
+func (f *VAL) StatusV1API() val.StatusV1API {
+	return &f.StatusV1
+}
+
+// Fake of val.ConsoleMatchV1API, methods call the function field of the same name and return ErrNotStubbed if it is nil.
+//
+// Deprecated methods call their WithOptions variant.
+type VALConsoleMatchV1 struct {
+	ByIDFunc        func(ctx context.Context, route val.PlatformRoute, matchId string) (*val.ConsoleMatchMatchV1DTO, error)
+	ListByPUUIDFunc func(ctx context.Context, route val.PlatformRoute, puuid string, platformType string) (*val.ConsoleMatchMatchlistV1DTO, error)
+	RecentFunc      func(ctx context.Context, route val.PlatformRoute, queue string) (*val.ConsoleMatchRecentMatchesV1DTO, error)
+}
+
+func (f *VALConsoleMatchV1) ByID(ctx context.Context, route val.PlatformRoute, matchId string) (*val.ConsoleMatchMatchV1DTO, error) {
+	if f.ByIDFunc == nil {
+		err := fmt.Errorf("%w: VALConsoleMatchV1.ByID", ErrNotStubbed)
+		return nil, err
+	}
+	return f.ByIDFunc(ctx, route, matchId)
+}
+
+func (f *VALConsoleMatchV1) ListByPUUID(ctx context.Context, route val.PlatformRoute, puuid string, platformType string) (*val.ConsoleMatchMatchlistV1DTO, error) {
+	if f.ListByPUUIDFunc == nil {
+		err := fmt.Errorf("%w: VALConsoleMatchV1.ListByPUUID", ErrNotStubbed)
+		return nil, err
+	}
+	return f.ListByPUUIDFunc(ctx, route, puuid, platformType)
+}
+
+func (f *VALConsoleMatchV1) Recent(ctx context.Context, route val.PlatformRoute, queue string) (*val.ConsoleMatchRecentMatchesV1DTO, error) {
+	if f.RecentFunc == nil {
+		err := fmt.Errorf("%w: VALConsoleMatchV1.Recent", ErrNotStubbed)
+		return nil, err
+	}
+	return f.RecentFunc(ctx, route, queue)
+}
+
+// Fake of val.ConsoleRankedV1API, methods call the function field of the same name and return ErrNotStubbed if it is nil.
+//
+// Deprecated methods call their WithOptions variant.
+type VALConsoleRankedV1 struct {
+	LeaderboardWithOptionsFunc func(ctx context.Context, route val.PlatformRoute, actId string, platformType string, opts *val.ConsoleRankedV1LeaderboardOptions) (*val.ConsoleRankedLeaderboardV1DTO, error)
+}
+
+func (f *VALConsoleRankedV1) Leaderboard(ctx context.Context, route val.PlatformRoute, actId string, platformType string, startIndex int, size int) (*val.ConsoleRankedLeaderboardV1DTO, error) {
+	opts := &val.ConsoleRankedV1LeaderboardOptions{}
+	if startIndex != -1 {
+		opts.StartIndex = &startIndex
+	}
+	if size != -1 {
+		opts.Size = &size
+	}
+	return f.LeaderboardWithOptions(ctx, route, actId, platformType, opts)
+}
+
+func (f *VALConsoleRankedV1) LeaderboardWithOptions(ctx context.Context, route val.PlatformRoute, actId string, platformType string, opts *val.ConsoleRankedV1LeaderboardOptions) (*val.ConsoleRankedLeaderboardV1DTO, error) {
+	if f.LeaderboardWithOptionsFunc == nil {
+		err := fmt.Errorf("%w: VALConsoleRankedV1.LeaderboardWithOptions", ErrNotStubbed)
+		return nil, err
+	}
+	return f.LeaderboardWithOptionsFunc(ctx, route, actId, platformType, opts)
+}
+
+// Fake of val.ContentV1API, methods call the function field of the same name and return ErrNotStubbed if it is nil.
+//
+// Deprecated methods call their WithOptions variant.
+type VALContentV1 struct {
+	ContentWithOptionsFunc func(ctx context.Context, route val.PlatformRoute, opts *val.ContentV1ContentOptions) (*val.ContentV1DTO, error)
+}
+
+func (f *VALContentV1) Content(ctx context.Context, route val.PlatformRoute, locale string) (*val.ContentV1DTO, error) {
+	opts := &val.ContentV1ContentOptions{}
+	if locale != "" {
+		opts.Locale = &locale
+	}
+	return f.ContentWithOptions(ctx, route, opts)
+}
+
+func (f *VALContentV1) ContentWithOptions(ctx context.Context, route val.PlatformRoute, opts *val.ContentV1ContentOptions) (*val.ContentV1DTO, error) {
+	if f.ContentWithOptionsFunc == nil {
+		err := fmt.Errorf("%w: VALContentV1.ContentWithOptions", ErrNotStubbed)
+		return nil, err
+	}
+	return f.ContentWithOptionsFunc(ctx, route, opts)
+}
+
+// Fake of val.MatchV1API, methods call the function field of the same name and return ErrNotStubbed if it is nil.
+//
+// Deprecated methods call their WithOptions variant.
+type VALMatchV1 struct {
+	ByIDFunc        func(ctx context.Context, route val.PlatformRoute, matchId string) (*val.MatchV1DTO, error)
+	ListByPUUIDFunc func(ctx context.Context, route val.PlatformRoute, puuid string) (*val.MatchlistV1DTO, error)
+	RecentFunc      func(ctx context.Context, route val.PlatformRoute, queue string) (*val.MatchRecentMatchesV1DTO, error)
+}
+
+func (f *VALMatchV1) ByID(ctx context.Context, route val.PlatformRoute, matchId string) (*val.MatchV1DTO, error) {
+	if f.ByIDFunc == nil {
+		err := fmt.Errorf("%w: VALMatchV1.ByID", ErrNotStubbed)
+		return nil, err
+	}
+	return f.ByIDFunc(ctx, route, matchId)
+}
+
+func (f *VALMatchV1) ListByPUUID(ctx context.Context, route val.PlatformRoute, puuid string) (*val.MatchlistV1DTO, error) {
+	if f.ListByPUUIDFunc == nil {
+		err := fmt.Errorf("%w: VALMatchV1.ListByPUUID", ErrNotStubbed)
+		return nil, err
+	}
+	return f.ListByPUUIDFunc(ctx, route, puuid)
+}
+
+func (f *VALMatchV1) Recent(ctx context.Context, route val.PlatformRoute, queue string) (*val.MatchRecentMatchesV1DTO, error) {
+	if f.RecentFunc == nil {
+		err := fmt.Errorf("%w: VALMatchV1.Recent", ErrNotStubbed)
+		return nil, err
+	}
+	return f.RecentFunc(ctx, route, queue)
+}
+
+// Fake of val.RankedV1API, methods call the function field of the same name and return ErrNotStubbed if it is nil.
+//
+// Deprecated methods call their WithOptions variant.
+type VALRankedV1 struct {
+	LeaderboardWithOptionsFunc func(ctx context.Context, route val.PlatformRoute, actId string, opts *val.RankedV1LeaderboardOptions) (*val.RankedLeaderboardV1DTO, error)
+}
+
+func (f *VALRankedV1) Leaderboard(ctx context.Context, route val.PlatformRoute, actId string, size int, startIndex int) (*val.RankedLeaderboardV1DTO, error) {
+	opts := &val.RankedV1LeaderboardOptions{}
+	if size != -1 {
+		opts.Size = &size
+	}
+	if startIndex != -1 {
+		opts.StartIndex = &startIndex
+	}
+	return f.LeaderboardWithOptions(ctx, route, actId, opts)
+}
+
+func (f *VALRankedV1) LeaderboardWithOptions(ctx context.Context, route val.PlatformRoute, actId string, opts *val.RankedV1LeaderboardOptions) (*val.RankedLeaderboardV1DTO, error) {
+	if f.LeaderboardWithOptionsFunc == nil {
+		err := fmt.Errorf("%w: VALRankedV1.LeaderboardWithOptions", ErrNotStubbed)
+		return nil, err
+	}
+	return f.LeaderboardWithOptionsFunc(ctx, route, actId, opts)
+}
+
+// Fake of val.StatusV1API, methods call the function field of the same name and return ErrNotStubbed if it is nil.
+//
+// Deprecated methods call their WithOptions variant.
+type VALStatusV1 struct {
+	PlatformFunc func(ctx context.Context, route val.PlatformRoute) (*val.StatusPlatformDataV1DTO, error)
+}
+
+func (f *VALStatusV1) Platform(ctx context.Context, route val.PlatformRoute) (*val.StatusPlatformDataV1DTO, error) {
+	if f.PlatformFunc == nil {
+		err := fmt.Errorf("%w: VALStatusV1.Platform", ErrNotStubbed)
+		return nil, err
+	}
+	return f.PlatformFunc(ctx, route)
+}
@@ -0,0 +1,112 @@
+package internal
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+
+	"github.com/Kyagara/equinox/v2/api"
+)
+
+// Fetches the data of a single ID.
+type BatchFunc[T any] func(ctx context.Context, id string) (T, error)
+
+// Returns a channel with the results of fetch for every ID received from ids, in completion order.
+//
+// At most opts.Concurrency IDs are fetched or waiting to be received at a time, an ID only starts when budget has a request left for it,
+// see RequestBudget, a nil budget only applies opts.Concurrency. Use total to report the number of IDs in opts.Progress.
+//
+// The channel returned is closed once ids is closed and all results were sent, or the context is cancelled.
+// On cancellation, IDs not started yet and results not sent are dropped and not counted in opts.Progress.
+func Batch[T any](ctx context.Context, budget *RequestBudget, ids <-chan string, total int, opts *api.BatchOptions, fetch BatchFunc[T]) <-chan api.BatchResult[T] {
+	if opts == nil {
+		opts = &api.BatchOptions{}
+	}
+
+	concurrency := max(opts.Concurrency, 1)
+	results := make(chan api.BatchResult[T], concurrency)
+
+	var mutex sync.Mutex
+	progress := api.BatchProgress{Total: total}
+
+	var running atomic.Int32
+	finished := make(chan struct{}, 1)
+	slots := make(chan struct{}, concurrency)
+
+	var wg sync.WaitGroup
+	go func() {
+		defer func() {
+			wg.Wait()
+			close(results)
+		}()
+
+		for {
+			var id string
+			var ok bool
+			select {
+			case <-ctx.Done():
+				return
+			case id, ok = <-ids:
+				if !ok {
+					return
+				}
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case slots <- struct{}{}:
+			}
+
+			err := budget.wait(ctx, func() int { return int(running.Load()) }, finished)
+			if err != nil {
+				return
+			}
+
+			running.Add(1)
+			wg.Add(1)
+			go func(id string) {
+				defer wg.Done()
+				defer func() { <-slots }()
+
+				data, err := fetch(ctx, id)
+				running.Add(-1)
+				notify(finished)
+
+				select {
+				case <-ctx.Done():
+					return
+				case results <- api.BatchResult[T]{ID: id, Data: data, Err: err}:
+				}
+
+				mutex.Lock()
+				defer mutex.Unlock()
+				progress.Done++
+				if err != nil {
+					progress.Failed++
+				}
+				if opts.Progress != nil {
+					opts.Progress(progress)
+				}
+			}(id)
+		}
+	}()
+
+	return results
+}
+
+// Same as Batch, but for a slice of IDs.
+func BatchSlice[T any](ctx context.Context, budget *RequestBudget, ids []string, opts *api.BatchOptions, fetch BatchFunc[T]) <-chan api.BatchResult[T] {
+	ch := make(chan string)
+	go func() {
+		defer close(ch)
+		for _, id := range ids {
+			select {
+			case <-ctx.Done():
+				return
+			case ch <- id:
+			}
+		}
+	}()
+	return Batch(ctx, budget, ch, len(ids), opts, fetch)
+}
@@ -0,0 +1,171 @@
+package internal_test
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Kyagara/equinox/v2"
+	"github.com/Kyagara/equinox/v2/api"
+	"github.com/Kyagara/equinox/v2/internal"
+	"github.com/Kyagara/equinox/v2/test/util"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBatch(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	ids := []string{"1", "2", "3", "4", "5", "6", "7", "8"}
+	errFetch := errors.New("fetch")
+
+	var running, peak atomic.Int32
+	fetch := func(ctx context.Context, id string) (string, error) {
+		current := running.Add(1)
+		defer running.Add(-1)
+		for {
+			old := peak.Load()
+			if current <= old || peak.CompareAndSwap(old, current) {
+				break
+			}
+		}
+		if id == "3" {
+			return "", errFetch
+		}
+		return "data" + id, nil
+	}
+
+	progress := []api.BatchProgress{}
+	opts := &api.BatchOptions{Concurrency: 3, Progress: func(p api.BatchProgress) {
+		progress = append(progress, p)
+	}}
+
+	got := []string{}
+	for res := range internal.BatchSlice(ctx, nil, ids, opts, fetch) {
+		if res.ID == "3" {
+			require.Equal(t, errFetch, res.Err)
+			continue
+		}
+		require.NoError(t, res.Err)
+		require.Equal(t, "data"+res.ID, res.Data)
+		got = append(got, res.ID)
+	}
+	sort.Strings(got)
+	require.Equal(t, []string{"1", "2", "4", "5", "6", "7", "8"}, got)
+	require.LessOrEqual(t, peak.Load(), int32(3))
+	require.Len(t, progress, 8)
+	require.Equal(t, api.BatchProgress{Total: 8, Done: 8, Failed: 1}, progress[7])
+
+	// From a channel, cancelled before it is closed
+	cancelled, cancel := context.WithCancel(ctx)
+	ch := make(chan string, 1)
+	ch <- "1"
+	results := internal.Batch(cancelled, nil, ch, 0, nil, fetch)
+	res := <-results
+	require.Equal(t, "data1", res.Data)
+	cancel()
+	for range results {
+	}
+}
+
+func TestBatchCancel(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ids := []string{"1", "2", "3", "4", "5", "6", "7", "8"}
+
+	// Every fetch after the first one finishes only after the cancellation
+	fetch := func(ctx context.Context, id string) (string, error) {
+		if id != "1" {
+			<-ctx.Done()
+		}
+		return "data" + id, nil
+	}
+
+	var mutex sync.Mutex
+	progress := api.BatchProgress{}
+	opts := &api.BatchOptions{Concurrency: 2, Progress: func(p api.BatchProgress) {
+		mutex.Lock()
+		defer mutex.Unlock()
+		progress = p
+	}}
+
+	received := 0
+	for res := range internal.BatchSlice(ctx, nil, ids, opts, fetch) {
+		received++
+		if res.ID == "1" {
+			cancel()
+		}
+	}
+
+	// IDs not started and results not received are dropped, Progress only counts received results
+	mutex.Lock()
+	defer mutex.Unlock()
+	require.Less(t, received, len(ids))
+	require.Equal(t, received, progress.Done)
+}
+
+func TestBatchBudget(t *testing.T) {
+	t.Parallel()
+
+	client, _ := newBudgetClient(t, "americas", "match-v5.getMatch", "0")
+	budget := client.RequestBudget("americas", "match-v5.getMatch")
+
+	// 3 requests left, fetch doesn't send requests so the budget is only taken by running IDs
+	var running, peak atomic.Int32
+	fetch := func(ctx context.Context, id string) (string, error) {
+		current := running.Add(1)
+		defer running.Add(-1)
+		for {
+			old := peak.Load()
+			if current <= old || peak.CompareAndSwap(old, current) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+		return id, nil
+	}
+
+	ctx := context.Background()
+	got := []string{}
+	for res := range internal.BatchSlice(ctx, budget, []string{"1", "2", "3", "4", "5", "6", "7", "8"}, &api.BatchOptions{Concurrency: 8}, fetch) {
+		require.NoError(t, res.Err)
+		got = append(got, res.Data)
+	}
+	sort.Strings(got)
+	require.Equal(t, []string{"1", "2", "3", "4", "5", "6", "7", "8"}, got)
+	require.Equal(t, int32(3), peak.Load())
+}
+
+func TestMatchBatchByID(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", "https://americas.api.riotgames.com/lol/match/v5/matches/BR1_1",
+		httpmock.NewStringResponder(200, `{"metadata":{"matchId":"BR1_1"}}`))
+	httpmock.RegisterResponder("GET", "https://americas.api.riotgames.com/lol/match/v5/matches/BR1_2",
+		httpmock.NewStringResponder(404, `{}`))
+
+	config := util.NewTestEquinoxConfig()
+	client, err := equinox.NewCustomClient(config, nil, nil, nil)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	results := map[string]error{}
+	for res := range client.LOL.MatchV5.BatchByID(ctx, api.AMERICAS, []string{"BR1_1", "BR1_2"}, &api.BatchOptions{Concurrency: 2}) {
+		results[res.ID] = res.Err
+		if res.Err == nil {
+			require.Equal(t, res.ID, res.Data.Metadata.MatchID)
+		}
+	}
+	require.Len(t, results, 2)
+	require.NoError(t, results["BR1_1"])
+	require.ErrorIs(t, results["BR1_2"], api.ErrNotFound)
+}
@@ -0,0 +1,76 @@
+package internal
+
+import (
+	"context"
+	"time"
+
+	"github.com/Kyagara/equinox/v2/api"
+	"github.com/Kyagara/equinox/v2/ratelimit"
+)
+
+// Limits the jobs started by FanOut and Batch to the requests the rate limiter allows without waiting, so they don't start goroutines that sit blocked in Reserve.
+type RequestBudget struct {
+	client   *Client
+	route    string
+	methodID string
+}
+
+// Returns a RequestBudget for jobs sending requests to the route and methodID, such as "americas" and "match-v5.getMatch".
+func (c *Client) RequestBudget(route string, methodID string) *RequestBudget {
+	return &RequestBudget{client: c, route: route, methodID: methodID}
+}
+
+// Returns the requests left in the rate limiter for the route and methodID, Remaining is -1 if the rate limit is disabled or no limits were received yet.
+func (c *Client) Budget(route string, methodID string) ratelimit.Budget {
+	if !c.IsRateLimitEnabled {
+		return ratelimit.Budget{Remaining: -1}
+	}
+	return c.ratelimit.Budget(route, methodID, false)
+}
+
+// Returns the clock used for waits, from 'api.EquinoxConfig'.
+func (c *Client) Clock() api.Clock {
+	return c.clock
+}
+
+// Blocks until another job can start. running returns the number of jobs started and not finished, finished receives when one of them finishes.
+//
+// A job starts when the rate limiter has a request left for it besides the running jobs, or when no job is running and no bucket is waiting for a reset.
+// Does nothing if the RequestBudget is nil. Must not be called concurrently.
+func (b *RequestBudget) wait(ctx context.Context, running func() int, finished <-chan struct{}) error {
+	if b == nil {
+		return ctx.Err()
+	}
+
+	for {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		n := running()
+		budget := b.client.Budget(b.route, b.methodID)
+		if budget.Remaining < 0 || budget.Remaining > n || (n == 0 && budget.Reset <= 0) {
+			return nil
+		}
+
+		var reset <-chan time.Time
+		if budget.Reset > 0 {
+			reset = b.client.clock.After(budget.Reset)
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-finished:
+		case <-reset:
+		}
+	}
+}
+
+// Signals a finished job without blocking, the channel must have a buffer of 1.
+func notify(finished chan<- struct{}) {
+	select {
+	case finished <- struct{}{}:
+	default:
+	}
+}
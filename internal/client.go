@@ -13,7 +13,9 @@ import (
 	"time"
 
 	jsonv2 "github.com/go-json-experiment/json"
+	"github.com/go-json-experiment/json/jsontext"
 	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/Kyagara/equinox/v2/api"
 	"github.com/Kyagara/equinox/v2/cache"
@@ -37,6 +39,10 @@ type Client struct {
 	cache              *cache.Cache
 	ratelimit          *ratelimit.RateLimit
 	loggers            loggers
+	tracer             trace.Tracer
+	metrics            api.Metrics
+	listeners          *api.Listeners
+	clock              api.Clock
 	key                string
 	maxRetries         int
 	jitter             time.Duration
@@ -70,6 +76,7 @@ func NewInternalClient(config api.EquinoxConfig, h *http.Client, c *cache.Cache,
 		},
 		cache:              c,
 		ratelimit:          r,
+		clock:              api.SystemClock{},
 		maxRetries:         config.Retry.MaxRetries,
 		jitter:             config.Retry.Jitter,
 		IsCacheEnabled:     c.TTL > 0,
@@ -77,6 +84,31 @@ func NewInternalClient(config api.EquinoxConfig, h *http.Client, c *cache.Cache,
 		IsRetryEnabled:     config.Retry.MaxRetries > 0,
 	}
 
+	if config.Metrics != nil {
+		client.metrics = config.Metrics
+		if r.Metrics == nil {
+			r.Metrics = config.Metrics
+		}
+	}
+
+	if config.Listeners != nil {
+		client.listeners = config.Listeners
+		if r.Listeners == nil {
+			r.Listeners = config.Listeners
+		}
+	}
+
+	if config.Clock != nil {
+		client.clock = config.Clock
+		if r.Clock == nil {
+			r.Clock = config.Clock
+		}
+	}
+
+	if config.TracerProvider != nil {
+		client.tracer = config.TracerProvider.Tracer(TRACER_NAME)
+	}
+
 	apiHeaders.Set("X-Riot-Token", config.Key)
 	return client, nil
 }
@@ -123,36 +155,33 @@ func (c *Client) Request(ctx context.Context, logger zerolog.Logger, httpMethod
 // Executes a 'EquinoxRequest', checks cache and unmarshals the response into 'target'.
 //
 // ctx accepts 'api.ExecuteOptions', 'api.Revalidate' for example can be used to revalidate the cache, forcing an update to it.
-func (c *Client) Execute(ctx context.Context, equinoxReq api.EquinoxRequest, target any) error {
+func (c *Client) Execute(ctx context.Context, equinoxReq api.EquinoxRequest, target any) (err error) {
 	equinoxReq.Logger.Trace().Msg("Execute")
 
 	if ctx == nil {
 		return ErrContextIsNil
 	}
 
-	key, isRSO := cache.GetCacheKey(equinoxReq.URL, equinoxReq.Request.Header.Get("Authorization"))
+	ctx, span := c.startSpan(ctx, &equinoxReq)
+	defer func() { endSpan(span, err) }()
 
-	if c.IsCacheEnabled && equinoxReq.Request.Method == http.MethodGet {
-		revalidate := ctx.Value(api.Revalidate)
-		if revalidate == nil {
-			item, err := c.cache.Get(ctx, key)
-			if err != nil {
-				equinoxReq.Logger.Error().Err(err).Msg("Error retrieving cached response")
-				return err
-			}
+	authHeader := equinoxReq.Request.Header.Get("Authorization")
+	key := c.cache.Key(equinoxReq.Request.URL, authHeader)
+	isRSO := authHeader != ""
 
-			if item != nil {
-				equinoxReq.Logger.Debug().Str("route", equinoxReq.Route).Msg("Cache hit")
+	item, err := c.getCachedItem(ctx, equinoxReq, key)
+	if err != nil {
+		return err
+	}
 
-				// Only valid json is cached, so unmarshal shouldn't fail
-				_ = jsonv2.Unmarshal(item, target)
-				return nil
-			}
-		}
+	if item != nil {
+		// Only valid json is cached, so unmarshal shouldn't fail
+		_ = jsonv2.Unmarshal(item, target)
+		return nil
 	}
 
 	if c.IsRateLimitEnabled {
-		err := c.ratelimit.Reserve(ctx, equinoxReq.Logger, equinoxReq.Route, equinoxReq.MethodID, isRSO)
+		err := c.reserve(ctx, equinoxReq, isRSO)
 		if err != nil {
 			return err
 		}
@@ -161,6 +190,7 @@ func (c *Client) Execute(ctx context.Context, equinoxReq api.EquinoxRequest, tar
 	response, err := c.Do(ctx, equinoxReq)
 	if err != nil {
 		equinoxReq.Logger.Error().Err(err).Msg("Do failed")
+		c.setCachedNotFound(ctx, equinoxReq, key, err)
 		return err
 	}
 	defer response.Body.Close()
@@ -209,7 +239,7 @@ func (c *Client) Execute(ctx context.Context, equinoxReq api.EquinoxRequest, tar
 		return err
 	}
 
-	err = c.cache.Set(ctx, key, body)
+	err = c.setCachedItem(ctx, equinoxReq, key, body)
 	if err != nil {
 		equinoxReq.Logger.Error().Err(err).Msg("Error caching item")
 		return err
@@ -219,17 +249,34 @@ func (c *Client) Execute(ctx context.Context, equinoxReq api.EquinoxRequest, tar
 	return nil
 }
 
-// Executes a 'EquinoxRequest', skips checking cache and returns []byte.
-func (c *Client) ExecuteBytes(ctx context.Context, equinoxReq api.EquinoxRequest) ([]byte, error) {
+// Executes a 'EquinoxRequest', checks cache and returns []byte.
+//
+// ctx accepts 'api.ExecuteOptions', 'api.Revalidate' for example can be used to revalidate the cache, forcing an update to it.
+func (c *Client) ExecuteBytes(ctx context.Context, equinoxReq api.EquinoxRequest) (_ []byte, err error) {
 	equinoxReq.Logger.Trace().Msg("ExecuteBytes")
 
 	if ctx == nil {
 		return nil, ErrContextIsNil
 	}
 
+	ctx, span := c.startSpan(ctx, &equinoxReq)
+	defer func() { endSpan(span, err) }()
+
+	authHeader := equinoxReq.Request.Header.Get("Authorization")
+	key := c.cache.Key(equinoxReq.Request.URL, authHeader)
+	isRSO := authHeader != ""
+
+	item, err := c.getCachedItem(ctx, equinoxReq, key)
+	if err != nil {
+		return nil, err
+	}
+
+	if item != nil {
+		return item, nil
+	}
+
 	if c.IsRateLimitEnabled {
-		isRSO := equinoxReq.Request.Header.Get("Authorization") != ""
-		err := c.ratelimit.Reserve(ctx, equinoxReq.Logger, equinoxReq.Route, equinoxReq.MethodID, isRSO)
+		err := c.reserve(ctx, equinoxReq, isRSO)
 		if err != nil {
 			return nil, err
 		}
@@ -238,6 +285,7 @@ func (c *Client) ExecuteBytes(ctx context.Context, equinoxReq api.EquinoxRequest
 	response, err := c.Do(ctx, equinoxReq)
 	if err != nil {
 		equinoxReq.Logger.Error().Err(err).Msg("Do failed")
+		c.setCachedNotFound(ctx, equinoxReq, key, err)
 		return nil, err
 	}
 	defer response.Body.Close()
@@ -248,23 +296,140 @@ func (c *Client) ExecuteBytes(ctx context.Context, equinoxReq api.EquinoxRequest
 		return nil, err
 	}
 
+	// Same as 'Execute', only cache valid json from a Get
+	if !c.IsCacheEnabled || equinoxReq.Request.Method != http.MethodGet || !jsontext.Value(body).IsValid() {
+		return body, nil
+	}
+
+	err = c.setCachedItem(ctx, equinoxReq, key, body)
+	if err != nil {
+		equinoxReq.Logger.Error().Err(err).Msg("Error caching item")
+		return nil, err
+	}
+
+	equinoxReq.Logger.Debug().Str("route", equinoxReq.Route).Msg("Cache set")
 	return body, nil
 }
 
+// Returns the cached response for a Get request, nil if not found, the cache is disabled or 'api.Revalidate' is set.
+func (c *Client) getCachedItem(ctx context.Context, equinoxReq api.EquinoxRequest, key string) ([]byte, error) {
+	if !c.IsCacheEnabled || equinoxReq.Request.Method != http.MethodGet {
+		return nil, nil
+	}
+
+	revalidate := ctx.Value(api.Revalidate)
+	if revalidate != nil {
+		return nil, nil
+	}
+
+	item, err := c.cache.GetWithStats(ctx, equinoxReq.MethodID, key)
+	if c.metrics != nil {
+		if err == nil || errors.Is(err, api.ErrNotFound) {
+			c.metrics.IncCacheLookup(equinoxReq.MethodID, item != nil || err != nil)
+		} else {
+			c.metrics.IncCacheError(equinoxReq.MethodID)
+		}
+	}
+
+	if span := c.span(ctx); span.IsRecording() {
+		span.AddEvent(CACHE_LOOKUP_EVENT, trace.WithAttributes(CACHE_HIT_ATTRIBUTE.Bool(item != nil || errors.Is(err, api.ErrNotFound))))
+	}
+
+	if errors.Is(err, api.ErrNotFound) {
+		equinoxReq.Logger.Debug().Str("route", equinoxReq.Route).Msg("Cache hit, not found")
+		c.listeners.EmitCacheHit(api.CacheHitEvent{Route: equinoxReq.Route, MethodID: equinoxReq.MethodID, NotFound: true})
+		return nil, err
+	}
+
+	if err != nil {
+		equinoxReq.Logger.Error().Err(err).Msg("Error retrieving cached response")
+		return nil, err
+	}
+
+	if item != nil {
+		equinoxReq.Logger.Debug().Str("route", equinoxReq.Route).Msg("Cache hit")
+		c.listeners.EmitCacheHit(api.CacheHitEvent{Route: equinoxReq.Route, MethodID: equinoxReq.MethodID})
+	}
+
+	return item, nil
+}
+
+// Saves a response in the cache, reporting the set or error to the Metrics.
+func (c *Client) setCachedItem(ctx context.Context, equinoxReq api.EquinoxRequest, key string, body []byte) error {
+	err := c.cache.SetWithStats(ctx, equinoxReq.MethodID, key, body)
+	if c.metrics == nil {
+		return err
+	}
+	if err != nil {
+		c.metrics.IncCacheError(equinoxReq.MethodID)
+	} else {
+		c.metrics.IncCacheSet(equinoxReq.MethodID, len(body))
+	}
+	return err
+}
+
+// Waits for the rate limiter, recording the time waited in the span.
+func (c *Client) reserve(ctx context.Context, equinoxReq api.EquinoxRequest, isRSO bool) error {
+	span := c.span(ctx)
+	if !span.IsRecording() {
+		return c.ratelimit.Reserve(ctx, equinoxReq.Logger, equinoxReq.Route, equinoxReq.MethodID, isRSO)
+	}
+
+	start := c.clock.Now()
+	err := c.ratelimit.Reserve(ctx, equinoxReq.Logger, equinoxReq.Route, equinoxReq.MethodID, isRSO)
+	wait := RATE_LIMIT_WAIT_ATTRIBUTE.Int64(c.clock.Now().Sub(start).Milliseconds())
+	span.AddEvent(RATE_LIMIT_WAIT_EVENT, trace.WithAttributes(wait))
+	span.SetAttributes(wait)
+	return err
+}
+
+// Remembers a 404 response to a Get request if negative caching is enabled.
+func (c *Client) setCachedNotFound(ctx context.Context, equinoxReq api.EquinoxRequest, key string, err error) {
+	if !c.IsCacheEnabled || c.cache.NotFoundTTL <= 0 || equinoxReq.Request.Method != http.MethodGet || !errors.Is(err, api.ErrNotFound) {
+		return
+	}
+
+	err = c.cache.SetNotFound(ctx, key)
+	if err != nil {
+		equinoxReq.Logger.Error().Err(err).Msg("Error caching not found response")
+		return
+	}
+
+	equinoxReq.Logger.Debug().Str("route", equinoxReq.Route).Msg("Cache set, not found")
+}
+
 // Sends the request using the internal http.Client, retries if enabled.
 func (c *Client) Do(ctx context.Context, equinoxReq api.EquinoxRequest) (*http.Response, error) {
 	equinoxReq.Logger.Trace().Msg("Do")
 
 	var httpErr error
+	span := c.span(ctx)
 
 	// MaxRetries+1 to run this loop at least once
 	for i := range c.maxRetries + 1 {
+		start := c.clock.Now()
 		response, err := c.http.Do(equinoxReq.Request)
 		if err != nil {
+			if c.metrics != nil {
+				c.metrics.ObserveRequest(equinoxReq.MethodID, equinoxReq.Route, 0, c.clock.Now().Sub(start))
+			}
 			// Stop if the http.Client itself returns any error
 			return nil, err
 		}
 
+		if c.metrics != nil {
+			c.metrics.ObserveRequest(equinoxReq.MethodID, equinoxReq.Route, response.StatusCode, c.clock.Now().Sub(start))
+		}
+
+		if span.IsRecording() {
+			span.AddEvent(HTTP_ROUND_TRIP_EVENT, trace.WithAttributes(
+				ATTEMPT_ATTRIBUTE.Int(i),
+				STATUS_CODE_ATTRIBUTE.Int(response.StatusCode),
+				DURATION_ATTRIBUTE.Int64(c.clock.Now().Sub(start).Milliseconds()),
+			))
+			span.SetAttributes(STATUS_CODE_ATTRIBUTE.Int(response.StatusCode))
+		}
+
 		delay, retryable, err := c.checkResponse(ctx, equinoxReq, response)
 		if err == nil && delay == 0 {
 			equinoxReq.Logger.Trace().Str("route", equinoxReq.Route).Msg("Success")
@@ -279,7 +444,21 @@ func (c *Client) Do(ctx context.Context, equinoxReq api.EquinoxRequest) (*http.R
 			// Exponential backoff with jitter
 			wait := delay*time.Duration(math.Pow(2, float64(i))) + c.jitter
 			equinoxReq.Logger.Warn().Str("route", equinoxReq.Route).Str("status_code", response.Status).Dur("wait", wait).Int("retries", i).Msg("Retrying request")
-			err := ratelimit.WaitN(ctx, time.Now().Add(wait), wait)
+			if c.metrics != nil {
+				c.metrics.IncRetry(equinoxReq.MethodID, equinoxReq.Route)
+			}
+			c.listeners.EmitRetry(api.RetryEvent{
+				Route:      equinoxReq.Route,
+				MethodID:   equinoxReq.MethodID,
+				StatusCode: response.StatusCode,
+				Attempt:    i + 1,
+				Wait:       wait,
+				Err:        err,
+			})
+			if span.IsRecording() {
+				span.AddEvent(RETRY_EVENT, trace.WithAttributes(ATTEMPT_ATTRIBUTE.Int(i+1), RETRY_WAIT_ATTRIBUTE.Int64(wait.Milliseconds())))
+			}
+			err := ratelimit.WaitNWithClock(ctx, c.clock, c.clock.Now().Add(wait), wait)
 			if err != nil {
 				return nil, err
 			}
@@ -298,6 +477,9 @@ func (c *Client) checkResponse(ctx context.Context, equinoxReq api.EquinoxReques
 
 	if response.StatusCode == http.StatusTooManyRequests {
 		retryAfter = ratelimit.GetRetryAfterHeader(ratelimit.RETRY_AFTER_HEADER)
+		if c.metrics != nil {
+			c.metrics.IncRateLimited(equinoxReq.MethodID, equinoxReq.Route, response.Header.Get(ratelimit.RATE_LIMIT_TYPE_HEADER))
+		}
 	}
 
 	if c.IsRateLimitEnabled {
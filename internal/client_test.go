@@ -14,12 +14,16 @@ import (
 	"github.com/Kyagara/equinox/v2/api"
 	"github.com/Kyagara/equinox/v2/cache"
 	"github.com/Kyagara/equinox/v2/clients/lol"
+	"github.com/Kyagara/equinox/v2/equinoxtest"
 	"github.com/Kyagara/equinox/v2/internal"
 	"github.com/Kyagara/equinox/v2/ratelimit"
 	"github.com/Kyagara/equinox/v2/test/util"
 	jsonv2 "github.com/go-json-experiment/json"
 	"github.com/jarcoal/httpmock"
 	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 )
 
 func TestNewInternalClient(t *testing.T) {
@@ -268,12 +272,163 @@ func TestExecutesWithCache(t *testing.T) {
 	require.Equal(t, `response2`, res)
 }
 
+func TestExecuteBytesWithCache(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", "https://br1.api.riotgames.com/lol/status/v4/platform-data",
+		httpmock.NewStringResponder(200, `"response"`).Once())
+
+	ctx := context.Background()
+
+	config := util.NewTestEquinoxConfig()
+	cache, err := equinox.DefaultCache()
+	require.NoError(t, err)
+	internalClient, err := internal.NewInternalClient(config, nil, cache, nil)
+	require.NoError(t, err)
+
+	logger := internalClient.Logger("client_endpoint_method")
+	urlComponents := []string{"https://", lol.BR1.String(), api.RIOT_API_BASE_URL_FORMAT, "/lol/status/v4/platform-data"}
+
+	equinoxReq, err := internalClient.Request(ctx, logger, http.MethodGet, urlComponents, "", nil)
+	require.NoError(t, err)
+
+	data, err := internalClient.ExecuteBytes(ctx, equinoxReq)
+	require.NoError(t, err)
+	require.Equal(t, []byte(`"response"`), data)
+
+	// Responder only replies once, this should be a cache hit
+	data, err = internalClient.ExecuteBytes(ctx, equinoxReq)
+	require.NoError(t, err)
+	require.Equal(t, []byte(`"response"`), data)
+
+	// Shares the same cache entry with Execute
+	var res string
+	err = internalClient.Execute(ctx, equinoxReq, &res)
+	require.NoError(t, err)
+	require.Equal(t, `response`, res)
+
+	// Revalidate skips the cache and updates it
+	httpmock.RegisterResponder("GET", "https://br1.api.riotgames.com/lol/status/v4/platform-data",
+		httpmock.NewStringResponder(200, `"response2"`).Once())
+
+	revalidateCtx := context.WithValue(ctx, api.Revalidate, true)
+	data, err = internalClient.ExecuteBytes(revalidateCtx, equinoxReq)
+	require.NoError(t, err)
+	require.Equal(t, []byte(`"response2"`), data)
+
+	data, err = internalClient.ExecuteBytes(ctx, equinoxReq)
+	require.NoError(t, err)
+	require.Equal(t, []byte(`"response2"`), data)
+
+	// Invalid json is not cached
+	urlComponents = []string{"https://", lol.BR1.String(), api.RIOT_API_BASE_URL_FORMAT, "/lol/status/v4/invalid"}
+	equinoxReq, err = internalClient.Request(ctx, logger, http.MethodGet, urlComponents, "", nil)
+	require.NoError(t, err)
+
+	httpmock.RegisterResponder("GET", "https://br1.api.riotgames.com/lol/status/v4/invalid",
+		httpmock.NewStringResponder(200, `-{invalid json}-`))
+
+	_, err = internalClient.ExecuteBytes(ctx, equinoxReq)
+	require.NoError(t, err)
+
+	item, err := cache.Get(ctx, "https://br1.api.riotgames.com/lol/status/v4/invalid")
+	require.NoError(t, err)
+	require.Nil(t, item)
+
+	// MethodID is empty for these requests
+	stats := cache.Stats()[""]
+	require.Equal(t, uint64(3), stats.Hits)
+	require.Equal(t, uint64(2), stats.Misses)
+	require.Equal(t, uint64(2), stats.Sets)
+}
+
+func TestExecuteWithNotFoundCache(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", "https://br1.api.riotgames.com/lol/spectator/v5/active-games/by-summoner/puuid",
+		httpmock.NewStringResponder(404, `{}`))
+
+	ctx := context.Background()
+
+	config := util.NewTestEquinoxConfig()
+	cache, err := equinox.DefaultCache()
+	require.NoError(t, err)
+	cache.NotFoundTTL = time.Minute
+	internalClient, err := internal.NewInternalClient(config, nil, cache, ratelimit.NewInternalRateLimit(0.99, time.Second))
+	require.NoError(t, err)
+
+	logger := internalClient.Logger("client_endpoint_method")
+	urlComponents := []string{"https://", lol.BR1.String(), api.RIOT_API_BASE_URL_FORMAT, "/lol/spectator/v5/active-games/by-summoner/puuid"}
+
+	equinoxReq, err := internalClient.Request(ctx, logger, http.MethodGet, urlComponents, "", nil)
+	require.NoError(t, err)
+
+	var res string
+	err = internalClient.Execute(ctx, equinoxReq, &res)
+	require.Equal(t, api.ErrNotFound, err)
+
+	// Both are served from the cache
+	err = internalClient.Execute(ctx, equinoxReq, &res)
+	require.Equal(t, api.ErrNotFound, err)
+	_, err = internalClient.ExecuteBytes(ctx, equinoxReq)
+	require.Equal(t, api.ErrNotFound, err)
+
+	require.Equal(t, 1, httpmock.GetTotalCallCount())
+
+	// Revalidate skips the cache
+	revalidateCtx := context.WithValue(ctx, api.Revalidate, true)
+	_, err = internalClient.ExecuteBytes(revalidateCtx, equinoxReq)
+	require.Equal(t, api.ErrNotFound, err)
+
+	require.Equal(t, 2, httpmock.GetTotalCallCount())
+}
+
+func TestExecuteCacheKeyQuery(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponderWithQuery("GET", "https://americas.api.riotgames.com/lol/match/v5/matches/by-puuid/puuid/ids", "count=1&start=0",
+		httpmock.NewStringResponder(200, `["BR1_1"]`).Once())
+	httpmock.RegisterResponderWithQuery("GET", "https://americas.api.riotgames.com/lol/match/v5/matches/by-puuid/puuid/ids", "count=1&start=1",
+		httpmock.NewStringResponder(200, `["BR1_2"]`).Once())
+
+	config := util.NewTestEquinoxConfig()
+	cache, err := equinox.DefaultCache()
+	require.NoError(t, err)
+	client, err := equinox.NewCustomClient(config, nil, cache, nil)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	ids, err := client.LOL.MatchV5.ListByPUUID(ctx, api.AMERICAS, "puuid", -1, -1, -1, "", 0, 1)
+	require.NoError(t, err)
+	require.Equal(t, []string{"BR1_1"}, ids)
+
+	// Different query parameters, not a cache hit
+	ids, err = client.LOL.MatchV5.ListByPUUID(ctx, api.AMERICAS, "puuid", -1, -1, -1, "", 1, 1)
+	require.NoError(t, err)
+	require.Equal(t, []string{"BR1_2"}, ids)
+
+	ids, err = client.LOL.MatchV5.ListByPUUID(ctx, api.AMERICAS, "puuid", -1, -1, -1, "", 0, 1)
+	require.NoError(t, err)
+	require.Equal(t, []string{"BR1_1"}, ids)
+
+	require.Equal(t, 2, httpmock.GetTotalCallCount())
+}
+
 func TestRateLimitRetry(t *testing.T) {
 	httpmock.Activate()
 	defer httpmock.DeactivateAndReset()
 
+	clock := equinoxtest.NewClock(time.Now())
+	clock.AutoAdvance = true
+	start := clock.Now()
+
 	config := util.NewTestEquinoxConfig()
 	config.Retry = api.Retry{MaxRetries: 1, Jitter: 500 * time.Millisecond}
+	config.Clock = clock
 	internalClient, err := internal.NewInternalClient(config, nil, nil, ratelimit.NewInternalRateLimit(0.99, time.Second))
 	require.NoError(t, err)
 
@@ -300,8 +455,7 @@ func TestRateLimitRetry(t *testing.T) {
 	equinoxReq, err := internalClient.Request(ctx, logger, http.MethodGet, urlComponents, "", nil)
 	require.NoError(t, err)
 
-	// Application rate limited
-	// This will take 2.5 seconds since it will retry one time. Retry-After + 0.5s of jitter
+	// Application rate limited, retries one time after Retry-After + 0.5s of jitter
 	err = internalClient.Execute(ctx, equinoxReq, &res)
 	require.ErrorIs(t, err, internal.ErrMaxRetries)
 
@@ -315,8 +469,7 @@ func TestRateLimitRetry(t *testing.T) {
 			"Retry-After":               {"1"},
 		}).Times(2))
 
-	// Method/Service rate limited
-	// This will take 2.5 seconds since it will retry one time. Retry-After + 0.5s of jitter
+	// Method/Service rate limited, waits the application RetryAfter and retries one time
 	err = internalClient.Execute(ctx, equinoxReq, &res)
 	require.ErrorIs(t, err, internal.ErrMaxRetries)
 
@@ -342,14 +495,16 @@ func TestRateLimitRetry(t *testing.T) {
 	err = internalClient.Execute(ctxWithDeadline, equinoxReq, &res)
 	require.Equal(t, ratelimit.ErrContextDeadlineExceeded, err)
 
-	// Method/Service rate limited
-	// This will take 2.5 seconds since it will retry one time and then succeed. DEFAULT_RETRY_AFTER + 0.5s of jitter
+	// Waits the method RetryAfter and succeeds
 	err = internalClient.Execute(ctx, equinoxReq, &res)
 	require.NoError(t, err)
 
 	// Wont block
 	_, err = internalClient.ExecuteBytes(ctx, equinoxReq)
 	require.NoError(t, err)
+
+	// 1.5 seconds for each retry and 1 second for each RetryAfter waited in Reserve
+	require.Equal(t, 5*time.Second, clock.Now().Sub(start))
 }
 
 func TestExponentialBackoffRetry(t *testing.T) {
@@ -359,9 +514,14 @@ func TestExponentialBackoffRetry(t *testing.T) {
 	httpmock.RegisterResponder("GET", "https://br1.api.riotgames.com/lol/status/v4/platform-data",
 		httpmock.NewStringResponder(429, `"response"`))
 
+	clock := equinoxtest.NewClock(time.Now())
+	clock.AutoAdvance = true
+	start := clock.Now()
+
 	config := util.NewTestEquinoxConfig()
 	config.Retry.MaxRetries = 2
 	config.Retry.Jitter = 200 * time.Millisecond
+	config.Clock = clock
 
 	internalClient, err := internal.NewInternalClient(config, nil, nil, nil)
 	require.NoError(t, err)
@@ -374,7 +534,120 @@ func TestExponentialBackoffRetry(t *testing.T) {
 	equinoxReq, err := internalClient.Request(ctx, logger, http.MethodGet, urlComponents, "", nil)
 	require.NoError(t, err)
 
-	// Should error out and take around 6.4 seconds
 	_, err = internalClient.ExecuteBytes(ctx, equinoxReq)
 	require.Error(t, err)
+
+	// DEFAULT_RETRY_AFTER doubled on each retry, plus the jitter
+	require.Equal(t, 3400*time.Millisecond, clock.Now().Sub(start))
+}
+
+func TestTracing(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", "https://americas.api.riotgames.com/lol/match/v5/matches/BR1_1",
+		httpmock.NewStringResponder(500, `{}`).Then(httpmock.NewStringResponder(200, `{"metadata": {"matchId": "BR1_1"}}`)))
+
+	exporter := tracetest.NewInMemoryExporter()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+
+	config := util.NewTestEquinoxConfig()
+	config.Retry.MaxRetries = 1
+	config.TracerProvider = provider
+
+	cache, err := equinox.DefaultCache()
+	require.NoError(t, err)
+	client, err := equinox.NewCustomClient(config, nil, cache, equinox.DefaultRateLimit())
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	match, err := client.LOL.MatchV5.ByID(ctx, api.AMERICAS, "BR1_1")
+	require.NoError(t, err)
+	require.Equal(t, "BR1_1", match.Metadata.MatchID)
+
+	_, err = client.LOL.MatchV5.ByID(ctx, api.AMERICAS, "BR1_1")
+	require.NoError(t, err)
+
+	spans := exporter.GetSpans()
+	require.Len(t, spans, 2)
+
+	span := spans[0]
+	require.Equal(t, "match-v5.getMatch", span.Name)
+	require.Contains(t, span.Attributes, internal.ROUTE_ATTRIBUTE.String("americas"))
+	require.Contains(t, span.Attributes, internal.STATUS_CODE_ATTRIBUTE.Int(200))
+
+	events := make([]string, 0, len(span.Events))
+	for _, event := range span.Events {
+		events = append(events, event.Name)
+	}
+	require.Equal(t, []string{
+		internal.CACHE_LOOKUP_EVENT,
+		internal.RATE_LIMIT_WAIT_EVENT,
+		internal.HTTP_ROUND_TRIP_EVENT,
+		internal.RETRY_EVENT,
+		internal.HTTP_ROUND_TRIP_EVENT,
+	}, events)
+	require.Contains(t, span.Events[0].Attributes, internal.CACHE_HIT_ATTRIBUTE.Bool(false))
+	require.Contains(t, span.Events[2].Attributes, internal.STATUS_CODE_ATTRIBUTE.Int(500))
+
+	cached := spans[1]
+	require.Len(t, cached.Events, 1)
+	require.Contains(t, cached.Events[0].Attributes, internal.CACHE_HIT_ATTRIBUTE.Bool(true))
+
+	// Errors are recorded in the span
+	httpmock.RegisterResponder("GET", "https://americas.api.riotgames.com/lol/match/v5/matches/BR1_2",
+		httpmock.NewStringResponder(404, `{}`))
+
+	_, err = client.LOL.MatchV5.ByID(ctx, api.AMERICAS, "BR1_2")
+	require.ErrorIs(t, err, api.ErrNotFound)
+
+	spans = exporter.GetSpans()
+	require.Len(t, spans, 3)
+	require.Equal(t, codes.Error, spans[2].Status.Code)
+}
+
+func TestListeners(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", "https://br1.api.riotgames.com/lol/status/v4/platform-data",
+		httpmock.NewStringResponder(503, `{}`).Then(httpmock.NewStringResponder(200, `"response"`)))
+
+	var retries []api.RetryEvent
+	var hits []api.CacheHitEvent
+
+	config := util.NewTestEquinoxConfig()
+	config.Retry.MaxRetries = 1
+	config.Listeners = &api.Listeners{
+		OnRetry:    func(event api.RetryEvent) { retries = append(retries, event) },
+		OnCacheHit: func(event api.CacheHitEvent) { hits = append(hits, event) },
+	}
+
+	cache, err := equinox.DefaultCache()
+	require.NoError(t, err)
+	rateLimit := equinox.DefaultRateLimit()
+	internalClient, err := internal.NewInternalClient(config, nil, cache, rateLimit)
+	require.NoError(t, err)
+	require.Equal(t, config.Listeners, rateLimit.Listeners)
+
+	ctx := context.Background()
+	logger := internalClient.Logger("client_endpoint_method")
+	urlComponents := []string{"https://", lol.BR1.String(), api.RIOT_API_BASE_URL_FORMAT, "/lol/status/v4/platform-data"}
+
+	equinoxReq, err := internalClient.Request(ctx, logger, http.MethodGet, urlComponents, "lol-status-v4.getPlatformData", nil)
+	require.NoError(t, err)
+
+	var res string
+	err = internalClient.Execute(ctx, equinoxReq, &res)
+	require.NoError(t, err)
+	require.Len(t, retries, 1)
+	require.Equal(t, 503, retries[0].StatusCode)
+	require.Equal(t, 1, retries[0].Attempt)
+	require.ErrorIs(t, retries[0].Err, api.ErrServiceUnavailable)
+	require.Empty(t, hits)
+
+	err = internalClient.Execute(ctx, equinoxReq, &res)
+	require.NoError(t, err)
+	require.Equal(t, "response", res)
+	require.Equal(t, []api.CacheHitEvent{{Route: "br1", MethodID: "lol-status-v4.getPlatformData"}}, hits)
 }
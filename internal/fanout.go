@@ -0,0 +1,109 @@
+package internal
+
+import (
+	"context"
+	"iter"
+	"sync/atomic"
+)
+
+// Fetches the results of a single job, passing every page to send as it arrives.
+//
+// send blocks until the page is yielded or buffered and returns false once the iteration stopped, the job should then return.
+type JobFunc[J any, T any] func(ctx context.Context, job J, send func(items []T) bool) error
+
+// Returns an iterator that runs fetch for every job, with at most concurrency jobs running or waiting to be yielded,
+// and yields their results in the order of jobs, pages of the current job are yielded as they arrive.
+//
+// Jobs ahead of the one being yielded buffer a single page, so memory is bounded by concurrency and the page size.
+//
+// A job only starts when budget has a request left for it, see RequestBudget, a nil budget only applies concurrency.
+// Iteration stops after the first error, remaining jobs are cancelled.
+func FanOut[J any, T any](ctx context.Context, budget *RequestBudget, jobs []J, concurrency int, fetch JobFunc[J, T]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		if concurrency < 1 {
+			concurrency = 1
+		}
+
+		// err and acquired are set before pages is closed
+		type state struct {
+			pages    chan []T
+			err      error
+			acquired bool
+		}
+
+		states := make([]*state, len(jobs))
+		for i := range states {
+			states[i] = &state{pages: make(chan []T, 1)}
+		}
+
+		var running atomic.Int32
+		finished := make(chan struct{}, 1)
+		slots := make(chan struct{}, concurrency)
+		go func() {
+			for i, job := range jobs {
+				s := states[i]
+				select {
+				case <-ctx.Done():
+					s.err = ctx.Err()
+					close(s.pages)
+					continue
+				case slots <- struct{}{}:
+					s.acquired = true
+				}
+
+				err := budget.wait(ctx, func() int { return int(running.Load()) }, finished)
+				if err != nil {
+					s.err = err
+					close(s.pages)
+					continue
+				}
+
+				running.Add(1)
+				go func(s *state, job J) {
+					stopped := false
+					send := func(items []T) bool {
+						select {
+						case <-ctx.Done():
+							stopped = true
+							return false
+						case s.pages <- items:
+							return true
+						}
+					}
+
+					err := fetch(ctx, job, send)
+					if err == nil && stopped {
+						err = ctx.Err()
+					}
+					running.Add(-1)
+					notify(finished)
+
+					s.err = err
+					close(s.pages)
+				}(s, job)
+			}
+		}()
+
+		var zero T
+		for _, s := range states {
+			for items := range s.pages {
+				for _, item := range items {
+					if !yield(item, nil) {
+						return
+					}
+				}
+			}
+			if s.acquired {
+				<-slots
+			}
+
+			if s.err != nil {
+				yield(zero, s.err)
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,182 @@
+package internal_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Kyagara/equinox/v2/equinoxtest"
+	"github.com/Kyagara/equinox/v2/internal"
+	"github.com/Kyagara/equinox/v2/ratelimit"
+	"github.com/Kyagara/equinox/v2/test/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFanOut(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	jobs := []int{1, 2, 3, 4, 5, 6}
+
+	var running, peak atomic.Int32
+	fetch := func(ctx context.Context, job int, send func([]int) bool) error {
+		current := running.Add(1)
+		defer running.Add(-1)
+		for {
+			old := peak.Load()
+			if current <= old || peak.CompareAndSwap(old, current) {
+				break
+			}
+		}
+		// Later jobs finish first, results are still yielded in order
+		time.Sleep(time.Duration(len(jobs)-job) * time.Millisecond)
+		send([]int{job})
+		send([]int{job * 10})
+		return nil
+	}
+
+	items := []int{}
+	for item, err := range internal.FanOut(ctx, nil, jobs, 3, fetch) {
+		require.NoError(t, err)
+		items = append(items, item)
+	}
+	require.Equal(t, []int{1, 10, 2, 20, 3, 30, 4, 40, 5, 50, 6, 60}, items)
+	require.LessOrEqual(t, peak.Load(), int32(3))
+
+	// Stops after the first error
+	errFetch := errors.New("fetch")
+	items = items[:0]
+	var count int
+	for item, err := range internal.FanOut(ctx, nil, jobs, 2, func(ctx context.Context, job int, send func([]int) bool) error {
+		if job == 2 {
+			return errFetch
+		}
+		send([]int{job})
+		return nil
+	}) {
+		count++
+		if err != nil {
+			require.Equal(t, errFetch, err)
+			continue
+		}
+		items = append(items, item)
+	}
+	require.Equal(t, []int{1}, items)
+	require.Equal(t, 2, count)
+
+	// Pages are yielded before their job returns
+	yielded := make(chan struct{})
+	items = items[:0]
+	for item, err := range internal.FanOut(ctx, nil, []int{1}, 1, func(ctx context.Context, job int, send func([]int) bool) error {
+		send([]int{1})
+		select {
+		case <-yielded:
+		case <-time.After(time.Second):
+			return errors.New("first page not yielded")
+		}
+		send([]int{2})
+		return nil
+	}) {
+		require.NoError(t, err)
+		items = append(items, item)
+		if item == 1 {
+			close(yielded)
+		}
+	}
+	require.Equal(t, []int{1, 2}, items)
+
+	// Breaking early cancels the remaining jobs
+	for item := range internal.FanOut(ctx, nil, jobs, 2, fetch) {
+		require.Equal(t, 1, item)
+		break
+	}
+}
+
+// Returns an internal client with a rate limiter that learned a method limit of 4 requests every 10 seconds, with count requests used.
+func newBudgetClient(t *testing.T, route string, methodID string, count string) (*internal.Client, *equinoxtest.Clock) {
+	clock := equinoxtest.NewClock(time.Now())
+	clock.AutoAdvance = true
+
+	config := util.NewTestEquinoxConfig()
+	config.Clock = clock
+	r := ratelimit.NewInternalRateLimit(1, 0)
+	client, err := internal.NewInternalClient(config, nil, nil, r)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	logger := util.NewTestLogger()
+	err = r.Reserve(ctx, logger, route, methodID, false)
+	require.NoError(t, err)
+	headers := http.Header{
+		ratelimit.METHOD_RATE_LIMIT_HEADER:       {"4:10"},
+		ratelimit.METHOD_RATE_LIMIT_COUNT_HEADER: {count + ":10"},
+	}
+	err = r.Update(ctx, logger, route, methodID, headers, 0)
+	require.NoError(t, err)
+	return client, clock
+}
+
+func TestFanOutBudget(t *testing.T) {
+	t.Parallel()
+
+	client, _ := newBudgetClient(t, "br1", "league-v4.getLeagueEntries", "0")
+	budget := client.RequestBudget("br1", "league-v4.getLeagueEntries")
+
+	// 3 requests left, jobs don't send requests so the budget is only taken by running jobs
+	var running, peak atomic.Int32
+	fetch := func(ctx context.Context, job int, send func([]int) bool) error {
+		current := running.Add(1)
+		defer running.Add(-1)
+		for {
+			old := peak.Load()
+			if current <= old || peak.CompareAndSwap(old, current) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+		send([]int{job})
+		return nil
+	}
+
+	ctx := context.Background()
+	items := []int{}
+	for item, err := range internal.FanOut(ctx, budget, []int{1, 2, 3, 4, 5, 6, 7, 8}, 8, fetch) {
+		require.NoError(t, err)
+		items = append(items, item)
+	}
+	require.Equal(t, []int{1, 2, 3, 4, 5, 6, 7, 8}, items)
+	require.Equal(t, int32(3), peak.Load())
+}
+
+func TestFanOutBudgetReset(t *testing.T) {
+	t.Parallel()
+
+	// No requests left, the first job waits for the reset instead of blocking in Reserve
+	client, clock := newBudgetClient(t, "br1", "league-v4.getLeagueEntries", "3")
+	budget := client.RequestBudget("br1", "league-v4.getLeagueEntries")
+	start := clock.Now()
+
+	ctx := context.Background()
+	for _, err := range internal.FanOut(ctx, budget, []int{1}, 2, func(ctx context.Context, job int, send func([]int) bool) error {
+		require.Equal(t, 10*time.Second, clock.Now().Sub(start))
+		send([]int{job})
+		return nil
+	}) {
+		require.NoError(t, err)
+	}
+
+	// Cancelled while waiting
+	client, _ = newBudgetClient(t, "br1", "league-v4.getLeagueEntries", "3")
+	budget = client.RequestBudget("br1", "league-v4.getLeagueEntries")
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	for _, err := range internal.FanOut(cancelled, budget, []int{1}, 2, func(ctx context.Context, job int, send func([]int) bool) error {
+		t.Fatal("job started")
+		return nil
+	}) {
+		require.ErrorIs(t, err, context.Canceled)
+	}
+}
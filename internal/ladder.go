@@ -0,0 +1,33 @@
+package internal
+
+import (
+	"github.com/Kyagara/equinox/v2/api"
+)
+
+// A tier and division of a ranked ladder, fetched as a single job by the Ladder helpers.
+type LadderJob[T ~string, D ~string] struct {
+	Tier     T
+	Division D
+}
+
+// Returns a job for every apex tier using apexDivision, followed by a job for every division of the other tiers, in the order given.
+func LadderJobs[T ~string, D ~string](apexTiers []T, apexDivision D, divisionTiers []T, divisions []D) []LadderJob[T, D] {
+	jobs := make([]LadderJob[T, D], 0, len(apexTiers)+len(divisionTiers)*len(divisions))
+	for _, tier := range apexTiers {
+		jobs = append(jobs, LadderJob[T, D]{Tier: tier, Division: apexDivision})
+	}
+	for _, tier := range divisionTiers {
+		for _, division := range divisions {
+			jobs = append(jobs, LadderJob[T, D]{Tier: tier, Division: division})
+		}
+	}
+	return jobs
+}
+
+// Returns the concurrency of a Ladder helper, DEFAULT_LADDER_CONCURRENCY if opts is nil or Concurrency is not positive.
+func LadderConcurrency(opts *api.LadderOptions) int {
+	if opts == nil || opts.Concurrency < 1 {
+		return api.DEFAULT_LADDER_CONCURRENCY
+	}
+	return opts.Concurrency
+}
@@ -1,9 +1,11 @@
 package internal
 
 import (
+	"io"
 	"os"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/Kyagara/equinox/v2/api"
 	"github.com/Kyagara/equinox/v2/cache"
@@ -66,23 +68,35 @@ func (r retryConfiguration) MarshalZerologObject(encoder *zerolog.Event) {
 }
 
 // Creates a new zerolog.Logger from an EquinoxConfig.
+//
+// zerolog's global variables are not modified.
 func NewLogger(config api.EquinoxConfig, cache *cache.Cache, ratelimit *ratelimit.RateLimit) zerolog.Logger {
-	if config == (api.EquinoxConfig{}) || config.Logger.Level == zerolog.Disabled {
+	if isEmptyConfig(config) || config.Logger.Level == zerolog.Disabled {
 		return zerolog.Nop()
 	}
 
-	zerolog.TimeFieldFormat = config.Logger.TimeFieldFormat
-
 	var logger zerolog.Logger
 
-	if config.Logger.Pretty {
-		logger = zerolog.New(zerolog.ConsoleWriter{Out: os.Stderr}).Level(config.Logger.Level)
-	} else {
-		logger = zerolog.New(os.Stderr).Level(config.Logger.Level)
-	}
+	switch {
+	case config.Logger.Handler != nil:
+		logger = zerolog.New(slogWriter{handler: config.Logger.Handler}).Level(config.Logger.Level)
+	case config.Logger.Zerolog != nil:
+		logger = *config.Logger.Zerolog
+	default:
+		var out io.Writer = os.Stderr
+		if config.Logger.Writer != nil {
+			out = config.Logger.Writer
+		}
 
-	if config.Logger.EnableTimestamp {
-		logger = logger.With().Timestamp().Logger()
+		if config.Logger.Pretty {
+			out = zerolog.ConsoleWriter{Out: out}
+		}
+
+		logger = zerolog.New(out).Level(config.Logger.Level)
+
+		if config.Logger.EnableTimestamp {
+			logger = logger.Hook(timestampHook{format: config.Logger.TimeFieldFormat})
+		}
 	}
 
 	var equinoxConfig configuration
@@ -121,6 +135,36 @@ func NewLogger(config api.EquinoxConfig, cache *cache.Cache, ratelimit *ratelimi
 	return logger
 }
 
+// Checks every field instead of comparing to api.EquinoxConfig{}, which panics if an interface holds an uncomparable value.
+func isEmptyConfig(config api.EquinoxConfig) bool {
+	logger := config.Logger
+	return config.Key == "" && config.Retry == (api.Retry{}) && config.TracerProvider == nil && config.Metrics == nil &&
+		config.Listeners == nil && config.Clock == nil &&
+		logger.Handler == nil && logger.Zerolog == nil && logger.Writer == nil && logger.TimeFieldFormat == "" &&
+		logger.Level == zerolog.DebugLevel && !logger.Pretty && !logger.EnableTimestamp && !logger.EnableConfigurationLogging
+}
+
+// Adds the timestamp with its own format, zerolog's Timestamp() uses the global TimeFieldFormat.
+type timestampHook struct {
+	format string
+}
+
+func (h timestampHook) Run(e *zerolog.Event, _ zerolog.Level, _ string) {
+	now := time.Now()
+	switch h.format {
+	case zerolog.TimeFormatUnix:
+		e.Int64(zerolog.TimestampFieldName, now.Unix())
+	case zerolog.TimeFormatUnixMs:
+		e.Int64(zerolog.TimestampFieldName, now.UnixMilli())
+	case zerolog.TimeFormatUnixMicro:
+		e.Int64(zerolog.TimestampFieldName, now.UnixMicro())
+	case zerolog.TimeFormatUnixNano:
+		e.Int64(zerolog.TimestampFieldName, now.UnixNano())
+	default:
+		e.Str(zerolog.TimestampFieldName, now.Format(h.format))
+	}
+}
+
 // Used to create/retrieve the zerolog.Logger for the specified endpoint method.
 func (c *Client) Logger(id string) zerolog.Logger {
 	c.loggers.mutex.Lock()
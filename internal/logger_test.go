@@ -1,11 +1,15 @@
 package internal_test
 
 import (
+	"bytes"
+	"log/slog"
 	"testing"
+	"time"
 
 	"github.com/Kyagara/equinox/v2/api"
 	"github.com/Kyagara/equinox/v2/internal"
 	"github.com/Kyagara/equinox/v2/test/util"
+	jsonv2 "github.com/go-json-experiment/json"
 	"github.com/rs/zerolog"
 	"github.com/stretchr/testify/require"
 )
@@ -31,6 +35,12 @@ func TestNewLogger(t *testing.T) {
 	config.Logger.TimeFieldFormat = zerolog.TimeFormatUnix
 	logger = internal.NewLogger(config, nil, nil)
 	require.True(t, logger.Info().Enabled())
+
+	// Only Listeners or Clock set, not an empty config
+	logger = internal.NewLogger(api.EquinoxConfig{Listeners: &api.Listeners{}}, nil, nil)
+	require.True(t, logger.Debug().Enabled())
+	logger = internal.NewLogger(api.EquinoxConfig{Clock: api.SystemClock{}}, nil, nil)
+	require.True(t, logger.Debug().Enabled())
 }
 
 func TestLogging(t *testing.T) {
@@ -49,3 +59,68 @@ func TestLogging(t *testing.T) {
 	logger.Warn().Msg("Warn log")
 	logger.Error().Msg("Error log")
 }
+
+func TestLoggerOutputs(t *testing.T) {
+	globalFormat := zerolog.TimeFieldFormat
+
+	// slog.Handler
+	var buf bytes.Buffer
+	config := util.NewTestEquinoxConfig()
+	config.Logger = api.Logger{
+		Handler: slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}),
+		Level:   zerolog.DebugLevel,
+	}
+
+	client, err := internal.NewInternalClient(config, nil, nil, nil)
+	require.NoError(t, err)
+
+	logger := client.Logger("lol_match-v5_ByID")
+	logger.Trace().Msg("Trace log")
+	logger.Warn().Int("retries", 1).Msg("Retrying request")
+
+	var record map[string]any
+	err = jsonv2.Unmarshal(buf.Bytes(), &record)
+	require.NoError(t, err)
+	require.Equal(t, "WARN", record["level"])
+	require.Equal(t, "Retrying request", record["msg"])
+	require.Equal(t, "lol", record["client"])
+	require.Equal(t, "match-v5", record["endpoint"])
+	require.Equal(t, "ByID", record["method"])
+	require.Equal(t, 1.0, record["retries"])
+
+	// io.Writer
+	buf.Reset()
+	config.Logger = api.Logger{
+		Writer:          &buf,
+		Level:           zerolog.InfoLevel,
+		EnableTimestamp: true,
+		TimeFieldFormat: time.RFC3339,
+	}
+
+	client, err = internal.NewInternalClient(config, nil, nil, nil)
+	require.NoError(t, err)
+
+	logger = client.Logger("lol_match-v5_ByID")
+	logger.Info().Msg("Info log")
+	record = nil
+	err = jsonv2.Unmarshal(buf.Bytes(), &record)
+	require.NoError(t, err)
+	require.Equal(t, "Info log", record["message"])
+	_, err = time.Parse(time.RFC3339, record["time"].(string))
+	require.NoError(t, err)
+
+	// zerolog.Logger
+	buf.Reset()
+	base := zerolog.New(&buf).With().Str("service", "test").Logger()
+	config.Logger = api.Logger{Zerolog: &base}
+
+	client, err = internal.NewInternalClient(config, nil, nil, nil)
+	require.NoError(t, err)
+
+	logger = client.Logger("lol_match-v5_ByID")
+	logger.Info().Msg("Info log")
+	require.Contains(t, buf.String(), `"service":"test"`)
+	require.Contains(t, buf.String(), `"method":"ByID"`)
+
+	require.Equal(t, globalFormat, zerolog.TimeFieldFormat)
+}
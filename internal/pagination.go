@@ -0,0 +1,54 @@
+package internal
+
+import (
+	"context"
+	"iter"
+)
+
+// Fetches a single page of results starting at the given index.
+type PageFunc[T any] func(ctx context.Context, start int, count int) ([]T, error)
+
+// Returns the first index and page size from the optional 'start' and 'count' query parameters, count defaults to defaultCount when not set or not positive.
+func PageBounds(start *int, count *int, defaultCount int) (int, int) {
+	first, size := 0, defaultCount
+	if start != nil {
+		first = *start
+	}
+	if count != nil && *count > 0 {
+		size = *count
+	}
+	return first, size
+}
+
+// Returns an iterator that calls fetch with increasing start indexes until a page returns fewer than count items.
+//
+// If the context is cancelled between pages, the context error is yielded once and iteration stops.
+func Paginate[T any](ctx context.Context, start int, count int, fetch PageFunc[T]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		for {
+			if err := ctx.Err(); err != nil {
+				yield(zero, err)
+				return
+			}
+
+			page, err := fetch(ctx, start, count)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+
+			for _, item := range page {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			if len(page) < count {
+				return
+			}
+
+			start += len(page)
+		}
+	}
+}
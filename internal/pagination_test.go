@@ -0,0 +1,172 @@
+package internal_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/Kyagara/equinox/v2"
+	"github.com/Kyagara/equinox/v2/api"
+	"github.com/Kyagara/equinox/v2/clients/lol"
+	"github.com/Kyagara/equinox/v2/clients/tft"
+	"github.com/Kyagara/equinox/v2/internal"
+	"github.com/Kyagara/equinox/v2/test/util"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPaginate(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	data := []int{1, 2, 3, 4, 5}
+	starts := []int{}
+	fetch := func(ctx context.Context, start int, count int) ([]int, error) {
+		starts = append(starts, start)
+		return data[start:min(start+count, len(data))], nil
+	}
+
+	items := []int{}
+	for item, err := range internal.Paginate(ctx, 0, 2, fetch) {
+		require.NoError(t, err)
+		items = append(items, item)
+	}
+	require.Equal(t, data, items)
+	require.Equal(t, []int{0, 2, 4}, starts)
+
+	// Stopping early doesn't request another page
+	starts = starts[:0]
+	for item := range internal.Paginate(ctx, 1, 2, fetch) {
+		require.Equal(t, 2, item)
+		break
+	}
+	require.Equal(t, []int{1}, starts)
+
+	// Errors are yielded once
+	errFetch := errors.New("fetch")
+	calls := 0
+	for _, err := range internal.Paginate(ctx, 0, 2, func(ctx context.Context, start int, count int) ([]int, error) {
+		return nil, errFetch
+	}) {
+		require.Equal(t, errFetch, err)
+		calls++
+	}
+	require.Equal(t, 1, calls)
+
+	// Cancelled context
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	starts = starts[:0]
+	for _, err := range internal.Paginate(cancelled, 0, 2, fetch) {
+		require.ErrorIs(t, err, context.Canceled)
+	}
+	require.Empty(t, starts)
+}
+
+func TestPageBounds(t *testing.T) {
+	t.Parallel()
+
+	start, count := internal.PageBounds(nil, nil, 100)
+	require.Equal(t, 0, start)
+	require.Equal(t, 100, count)
+
+	first, size, zero := 5, 20, 0
+	start, count = internal.PageBounds(&first, &size, 100)
+	require.Equal(t, 5, start)
+	require.Equal(t, 20, count)
+
+	// Not positive, uses the default
+	start, count = internal.PageBounds(nil, &zero, 100)
+	require.Equal(t, 0, start)
+	require.Equal(t, 100, count)
+}
+
+func TestMatchIDsIterator(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	url := "https://americas.api.riotgames.com/lol/match/v5/matches/by-puuid/puuid/ids"
+	httpmock.RegisterResponderWithQuery("GET", url, "count=2&start=0&startTime=1700000000",
+		httpmock.NewStringResponder(200, `["BR1_1","BR1_2"]`))
+	httpmock.RegisterResponderWithQuery("GET", url, "count=2&start=2&startTime=1700000000",
+		httpmock.NewStringResponder(200, `["BR1_3"]`))
+
+	config := util.NewTestEquinoxConfig()
+	client, err := equinox.NewCustomClient(config, nil, nil, nil)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	startTime, count := 1700000000, 2
+	ids := []string{}
+	for id, err := range client.LOL.MatchV5.AllIDsByPUUID(ctx, api.AMERICAS, "puuid", &lol.MatchV5ListByPUUIDOptions{StartTime: &startTime, Count: &count}) {
+		require.NoError(t, err)
+		ids = append(ids, id)
+	}
+	require.Equal(t, []string{"BR1_1", "BR1_2", "BR1_3"}, ids)
+	require.Equal(t, 2, httpmock.GetTotalCallCount())
+}
+
+func TestTFTMatchIDsIterator(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	url := "https://americas.api.riotgames.com/tft/match/v1/matches/by-puuid/puuid/ids"
+	httpmock.RegisterResponderWithQuery("GET", url, "count=2&start=5",
+		httpmock.NewStringResponder(200, `["BR1_1","BR1_2"]`))
+	httpmock.RegisterResponderWithQuery("GET", url, "count=2&start=7",
+		httpmock.NewStringResponder(200, `[]`))
+
+	config := util.NewTestEquinoxConfig()
+	client, err := equinox.NewCustomClient(config, nil, nil, nil)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	start, count := 5, 2
+	ids := []string{}
+	for id, err := range client.TFT.MatchV1.AllIDsByPUUID(ctx, api.AMERICAS, "puuid", &tft.MatchV1ListByPUUIDOptions{Start: &start, Count: &count}) {
+		require.NoError(t, err)
+		ids = append(ids, id)
+	}
+	require.Equal(t, []string{"BR1_1", "BR1_2"}, ids)
+	require.Equal(t, 2, httpmock.GetTotalCallCount())
+}
+
+func TestRSOMatchIDsIterator(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	// Default page size, a short first page stops the iteration
+	httpmock.RegisterResponderWithQuery("GET", "https://americas.api.riotgames.com/lol/rso-match/v1/matches/ids", "count=100&start=0",
+		func(req *http.Request) (*http.Response, error) {
+			require.Equal(t, "Bearer token", req.Header.Get("Authorization"))
+			require.Empty(t, req.Header.Get("X-Riot-Token"))
+			return httpmock.NewStringResponse(200, `["BR1_1","BR1_2"]`), nil
+		})
+
+	config := util.NewTestEquinoxConfig()
+	client, err := equinox.NewCustomClient(config, nil, nil, nil)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	ids := []string{}
+	for id, err := range client.LOL.RsoMatchV1.AllMatchIds(ctx, api.AMERICAS, "token", nil) {
+		require.NoError(t, err)
+		ids = append(ids, id)
+	}
+	require.Equal(t, []string{"BR1_1", "BR1_2"}, ids)
+	require.Equal(t, 1, httpmock.GetTotalCallCount())
+
+	// Invalid count, the error is yielded once
+	count := 101
+	calls := 0
+	for _, err := range client.LOL.RsoMatchV1.AllMatchIds(ctx, api.AMERICAS, "token", &lol.RsoMatchV1MatchIdsOptions{Count: &count}) {
+		require.ErrorIs(t, err, api.ErrInvalidParameter)
+		calls++
+	}
+	require.Equal(t, 1, calls)
+	require.Equal(t, 1, httpmock.GetTotalCallCount())
+}
@@ -0,0 +1,88 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"log/slog"
+	"time"
+
+	jsonv2 "github.com/go-json-experiment/json"
+	"github.com/go-json-experiment/json/jsontext"
+	"github.com/rs/zerolog"
+)
+
+// Forwards zerolog's JSON output to a slog.Handler.
+type slogWriter struct {
+	handler slog.Handler
+}
+
+func (w slogWriter) Write(p []byte) (int, error) {
+	return w.WriteLevel(zerolog.NoLevel, p)
+}
+
+// Converts the JSON object written by zerolog to a slog.Record, the 'level' and 'message' fields become the record's level and message.
+func (w slogWriter) WriteLevel(level zerolog.Level, p []byte) (int, error) {
+	ctx := context.Background()
+	slogLevel := slogLevel(level)
+	if !w.handler.Enabled(ctx, slogLevel) {
+		return len(p), nil
+	}
+
+	decoder := jsontext.NewDecoder(bytes.NewReader(p))
+	if _, err := decoder.ReadToken(); err != nil {
+		return 0, err
+	}
+
+	var message string
+	var attrs []slog.Attr
+	for decoder.PeekKind() != '}' {
+		token, err := decoder.ReadToken()
+		if err != nil {
+			return 0, err
+		}
+		// The token is voided by the next read
+		key := token.String()
+
+		value, err := decoder.ReadValue()
+		if err != nil {
+			return 0, err
+		}
+
+		switch key {
+		case zerolog.LevelFieldName:
+			continue
+		case zerolog.MessageFieldName:
+			_ = jsonv2.Unmarshal(value, &message)
+		default:
+			var v any
+			if err := jsonv2.Unmarshal(value, &v); err != nil {
+				return 0, err
+			}
+			attrs = append(attrs, slog.Any(key, v))
+		}
+	}
+
+	record := slog.NewRecord(time.Now(), slogLevel, message, 0)
+	record.AddAttrs(attrs...)
+	if err := w.handler.Handle(ctx, record); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+func slogLevel(level zerolog.Level) slog.Level {
+	switch level {
+	case zerolog.TraceLevel:
+		return slog.LevelDebug - 4
+	case zerolog.DebugLevel:
+		return slog.LevelDebug
+	case zerolog.WarnLevel:
+		return slog.LevelWarn
+	case zerolog.ErrorLevel:
+		return slog.LevelError
+	case zerolog.FatalLevel, zerolog.PanicLevel:
+		return slog.LevelError + 4
+	default:
+		return slog.LevelInfo
+	}
+}
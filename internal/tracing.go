@@ -0,0 +1,75 @@
+package internal
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+
+	"github.com/Kyagara/equinox/v2/api"
+)
+
+const TRACER_NAME = "github.com/Kyagara/equinox/v2"
+
+// Span event names.
+const (
+	CACHE_LOOKUP_EVENT    = "cache.lookup"
+	RATE_LIMIT_WAIT_EVENT = "ratelimit.wait"
+	RETRY_EVENT           = "retry"
+	HTTP_ROUND_TRIP_EVENT = "http.round_trip"
+)
+
+// Span and event attribute keys.
+const (
+	ROUTE_ATTRIBUTE           = attribute.Key("equinox.route")
+	METHOD_ID_ATTRIBUTE       = attribute.Key("equinox.method_id")
+	CACHE_HIT_ATTRIBUTE       = attribute.Key("equinox.cache.hit")
+	RATE_LIMIT_WAIT_ATTRIBUTE = attribute.Key("equinox.ratelimit.wait_ms")
+	RETRY_WAIT_ATTRIBUTE      = attribute.Key("equinox.retry.wait_ms")
+	ATTEMPT_ATTRIBUTE         = attribute.Key("equinox.attempt")
+	DURATION_ATTRIBUTE        = attribute.Key("equinox.duration_ms")
+	HTTP_METHOD_ATTRIBUTE     = attribute.Key("http.request.method")
+	STATUS_CODE_ATTRIBUTE     = attribute.Key("http.response.status_code")
+)
+
+// Returned when tracing is disabled, converting an empty struct to an interface doesn't allocate.
+var noopSpan trace.Span = noop.Span{}
+
+// Starts a span named after the request's method ID and sets the request's context to the span's context,
+// so instrumented http.RoundTrippers create child spans.
+func (c *Client) startSpan(ctx context.Context, equinoxReq *api.EquinoxRequest) (context.Context, trace.Span) {
+	if c.tracer == nil {
+		return ctx, noopSpan
+	}
+
+	ctx, span := c.tracer.Start(ctx, equinoxReq.MethodID,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			ROUTE_ATTRIBUTE.String(equinoxReq.Route),
+			METHOD_ID_ATTRIBUTE.String(equinoxReq.MethodID),
+			HTTP_METHOD_ATTRIBUTE.String(equinoxReq.Request.Method),
+		),
+	)
+
+	equinoxReq.Request = equinoxReq.Request.WithContext(ctx)
+	return ctx, span
+}
+
+// Returns the span started by 'startSpan', a no-op span if tracing is disabled.
+func (c *Client) span(ctx context.Context) trace.Span {
+	if c.tracer == nil {
+		return noopSpan
+	}
+	return trace.SpanFromContext(ctx)
+}
+
+// Records the error, if any, and ends the span.
+func endSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
@@ -0,0 +1,124 @@
+// This package keeps a local copy of the League of Legends match history of a set of players, fetching only matches newer than the last sync.
+package matchsync
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/Kyagara/equinox/v2"
+	"github.com/Kyagara/equinox/v2/api"
+	"github.com/Kyagara/equinox/v2/clients/lol"
+)
+
+// Called for every new match, oldest first. Returning an error stops the sync of the player without advancing its checkpoint.
+//
+// timeline is nil if Options.Timelines is false.
+type MatchFunc func(ctx context.Context, puuid string, match *lol.MatchV5DTO, timeline *lol.MatchTimelineV5DTO) error
+
+// Syncer configuration object.
+type Options struct {
+	// Also fetches the timeline of every new match.
+	Timelines bool
+	// Queue and Type filters sent with every ListByPUUID request. Start, Count and StartTime are set by the Syncer.
+	Filter *lol.MatchV5ListByPUUIDOptions
+}
+
+type Syncer struct {
+	client  *equinox.Equinox
+	store   Store
+	onMatch MatchFunc
+	opts    Options
+}
+
+// Creates a new Syncer, opts can be nil.
+func New(client *equinox.Equinox, store Store, onMatch MatchFunc, opts *Options) *Syncer {
+	s := &Syncer{client: client, store: store, onMatch: onMatch}
+	if opts != nil {
+		s.opts = *opts
+	}
+	return s
+}
+
+// Fetches the matches of a player newer than its checkpoint and returns how many were handled.
+//
+// The checkpoint is saved after every match, a failed sync resumes from the last match handled.
+func (s *Syncer) Sync(ctx context.Context, route api.RegionalRoute, puuid string) (int, error) {
+	checkpoint, err := s.store.Get(ctx, puuid)
+	if err != nil {
+		return 0, err
+	}
+	if checkpoint == nil {
+		checkpoint = &Checkpoint{PUUID: puuid}
+	}
+
+	var filter lol.MatchV5ListByPUUIDOptions
+	if s.opts.Filter != nil {
+		filter = *s.opts.Filter
+	}
+	filter.Start, filter.Count, filter.StartTime = nil, nil, nil
+	if checkpoint.LastStartTime > 0 {
+		filter.StartTime = &checkpoint.LastStartTime
+	}
+
+	// IDs are returned newest first, stop once the last fetched match is reached.
+	// The list is never read from the cache, a cached page would miss the matches played since
+	var ids []string
+	for id, err := range s.client.LOL.MatchV5.AllIDsByPUUID(context.WithValue(ctx, api.Revalidate, true), route, puuid, &filter) {
+		if err != nil {
+			return 0, err
+		}
+		if id == checkpoint.LastMatchID {
+			break
+		}
+		ids = append(ids, id)
+	}
+
+	for i := len(ids) - 1; i >= 0; i-- {
+		id := ids[i]
+
+		match, err := s.client.LOL.MatchV5.ByID(ctx, route, id)
+		if err != nil {
+			return len(ids) - 1 - i, fmt.Errorf("match %s: %w", id, err)
+		}
+
+		var timeline *lol.MatchTimelineV5DTO
+		if s.opts.Timelines {
+			timeline, err = s.client.LOL.MatchV5.Timeline(ctx, route, id)
+			if err != nil {
+				return len(ids) - 1 - i, fmt.Errorf("timeline %s: %w", id, err)
+			}
+		}
+
+		if err := s.onMatch(ctx, puuid, match, timeline); err != nil {
+			return len(ids) - 1 - i, err
+		}
+
+		checkpoint.LastMatchID = id
+		checkpoint.LastStartTime = match.Info.GameStartTimestamp / 1000
+		if err := s.store.Set(ctx, *checkpoint); err != nil {
+			return len(ids) - i, err
+		}
+	}
+
+	return len(ids), nil
+}
+
+// Syncs every player in order, returning the number of matches handled and the errors of all players that failed.
+func (s *Syncer) SyncAll(ctx context.Context, route api.RegionalRoute, puuids []string) (int, error) {
+	var total int
+	var errs []error
+	for _, puuid := range puuids {
+		if err := ctx.Err(); err != nil {
+			errs = append(errs, err)
+			break
+		}
+
+		n, err := s.Sync(ctx, route, puuid)
+		total += n
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", puuid, err))
+		}
+	}
+	return total, errors.Join(errs...)
+}
@@ -0,0 +1,180 @@
+package matchsync_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Kyagara/equinox/v2"
+	"github.com/Kyagara/equinox/v2/api"
+	"github.com/Kyagara/equinox/v2/cache"
+	"github.com/Kyagara/equinox/v2/clients/lol"
+	"github.com/Kyagara/equinox/v2/matchsync"
+	"github.com/Kyagara/equinox/v2/test/util"
+	"github.com/allegro/bigcache/v3"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/require"
+)
+
+const idsURL = "https://americas.api.riotgames.com/lol/match/v5/matches/by-puuid/puuid/ids"
+
+func registerMatches(ids ...int) {
+	for _, id := range ids {
+		matchID := fmt.Sprintf("BR1_%d", id)
+		httpmock.RegisterResponder("GET", "https://americas.api.riotgames.com/lol/match/v5/matches/"+matchID,
+			httpmock.NewStringResponder(200, fmt.Sprintf(`{"metadata":{"matchId":"%s"},"info":{"gameStartTimestamp":%d000}}`, matchID, id)))
+		httpmock.RegisterResponder("GET", "https://americas.api.riotgames.com/lol/match/v5/matches/"+matchID+"/timeline",
+			httpmock.NewStringResponder(200, fmt.Sprintf(`{"metadata":{"matchId":"%s"}}`, matchID)))
+	}
+}
+
+func TestSync(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	registerMatches(1, 2, 3, 4)
+	httpmock.RegisterResponderWithQuery("GET", idsURL, "count=100&queue=420&start=0",
+		httpmock.NewStringResponder(200, `["BR1_3","BR1_2","BR1_1"]`))
+	httpmock.RegisterResponderWithQuery("GET", idsURL, "count=100&queue=420&start=0&startTime=3",
+		httpmock.NewStringResponder(200, `["BR1_4","BR1_3"]`))
+
+	config := util.NewTestEquinoxConfig()
+	client, err := equinox.NewCustomClient(config, nil, nil, nil)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	path := filepath.Join(t.TempDir(), "checkpoints.json")
+	store, err := matchsync.NewFileStore(path)
+	require.NoError(t, err)
+
+	fetched := []string{}
+	onMatch := func(ctx context.Context, puuid string, match *lol.MatchV5DTO, timeline *lol.MatchTimelineV5DTO) error {
+		require.Equal(t, match.Metadata.MatchID, timeline.Metadata.MatchID)
+		fetched = append(fetched, match.Metadata.MatchID)
+		return nil
+	}
+
+	queue := lol.SUMMONERS_RIFT_5V5_RANKED_SOLO_QUEUE
+	opts := &matchsync.Options{Timelines: true, Filter: &lol.MatchV5ListByPUUIDOptions{Queue: &queue}}
+	syncer := matchsync.New(client, store, onMatch, opts)
+
+	n, err := syncer.Sync(ctx, api.AMERICAS, "puuid")
+	require.NoError(t, err)
+	require.Equal(t, 3, n)
+	require.Equal(t, []string{"BR1_1", "BR1_2", "BR1_3"}, fetched)
+
+	// Checkpoint persisted to the file
+	store, err = matchsync.NewFileStore(path)
+	require.NoError(t, err)
+	checkpoint, err := store.Get(ctx, "puuid")
+	require.NoError(t, err)
+	require.Equal(t, &matchsync.Checkpoint{PUUID: "puuid", LastMatchID: "BR1_3", LastStartTime: 3}, checkpoint)
+
+	// Only the new match
+	fetched = fetched[:0]
+	syncer = matchsync.New(client, store, onMatch, opts)
+	n, err = syncer.SyncAll(ctx, api.AMERICAS, []string{"puuid"})
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+	require.Equal(t, []string{"BR1_4"}, fetched)
+}
+
+func TestSyncResume(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	registerMatches(1, 2)
+	httpmock.RegisterResponderWithQuery("GET", idsURL, "count=100&start=0",
+		httpmock.NewStringResponder(200, `["BR1_2","BR1_1"]`))
+	httpmock.RegisterResponderWithQuery("GET", idsURL, "count=100&start=0&startTime=1",
+		httpmock.NewStringResponder(200, `["BR1_2","BR1_1"]`))
+
+	config := util.NewTestEquinoxConfig()
+	client, err := equinox.NewCustomClient(config, nil, nil, nil)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	store := matchsync.NewMemoryStore()
+
+	errHandler := errors.New("handler")
+	fetched := []string{}
+	onMatch := func(ctx context.Context, puuid string, match *lol.MatchV5DTO, timeline *lol.MatchTimelineV5DTO) error {
+		require.Nil(t, timeline)
+		if match.Metadata.MatchID == "BR1_2" && len(fetched) == 1 {
+			return errHandler
+		}
+		fetched = append(fetched, match.Metadata.MatchID)
+		return nil
+	}
+
+	syncer := matchsync.New(client, store, onMatch, nil)
+	n, err := syncer.Sync(ctx, api.AMERICAS, "puuid")
+	require.Equal(t, errHandler, err)
+	require.Equal(t, 1, n)
+
+	checkpoint, err := store.Get(ctx, "puuid")
+	require.NoError(t, err)
+	require.Equal(t, "BR1_1", checkpoint.LastMatchID)
+
+	fetched = append(fetched, "retry")
+	n, err = syncer.Sync(ctx, api.AMERICAS, "puuid")
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+	require.Equal(t, []string{"BR1_1", "retry", "BR1_2"}, fetched)
+}
+
+func TestSyncCache(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	registerMatches(1, 2)
+	httpmock.RegisterResponderWithQuery("GET", idsURL, "count=100&start=0",
+		httpmock.NewStringResponder(200, `["BR1_1"]`))
+
+	// BR1_2 is played after the second sync
+	var played atomic.Bool
+	httpmock.RegisterResponderWithQuery("GET", idsURL, "count=100&start=0&startTime=1",
+		func(req *http.Request) (*http.Response, error) {
+			if played.Load() {
+				return httpmock.NewStringResponse(200, `["BR1_2","BR1_1"]`), nil
+			}
+			return httpmock.NewStringResponse(200, `["BR1_1"]`), nil
+		})
+
+	ctx := context.Background()
+
+	c, err := cache.NewBigCache(ctx, bigcache.DefaultConfig(4*time.Minute))
+	require.NoError(t, err)
+
+	config := util.NewTestEquinoxConfig()
+	client, err := equinox.NewCustomClient(config, nil, c, nil)
+	require.NoError(t, err)
+
+	fetched := []string{}
+	onMatch := func(ctx context.Context, puuid string, match *lol.MatchV5DTO, timeline *lol.MatchTimelineV5DTO) error {
+		fetched = append(fetched, match.Metadata.MatchID)
+		return nil
+	}
+
+	syncer := matchsync.New(client, matchsync.NewMemoryStore(), onMatch, nil)
+	n, err := syncer.Sync(ctx, api.AMERICAS, "puuid")
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+
+	n, err = syncer.Sync(ctx, api.AMERICAS, "puuid")
+	require.NoError(t, err)
+	require.Equal(t, 0, n)
+
+	// Same request as the previous sync, the new match is not hidden by the cache
+	played.Store(true)
+	n, err = syncer.Sync(ctx, api.AMERICAS, "puuid")
+	require.NoError(t, err)
+	require.Equal(t, 1, n)
+	require.Equal(t, []string{"BR1_1", "BR1_2"}, fetched)
+}
@@ -0,0 +1,135 @@
+package matchsync
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sync"
+
+	jsonv2 "github.com/go-json-experiment/json"
+)
+
+// Sync state of a player.
+type Checkpoint struct {
+	PUUID string `json:"puuid"`
+	// Newest match ID already fetched.
+	LastMatchID string `json:"lastMatchId"`
+	// Start time, in epoch seconds, of the newest match already fetched. Sent as startTime in the next sync.
+	LastStartTime int `json:"lastStartTime"`
+}
+
+// Persists checkpoints between syncs.
+type Store interface {
+	// Returns the checkpoint of a player, nil if the player was never synced.
+	Get(ctx context.Context, puuid string) (*Checkpoint, error)
+	Set(ctx context.Context, checkpoint Checkpoint) error
+}
+
+// Store that keeps checkpoints in memory, lost when the process exits.
+type MemoryStore struct {
+	checkpoints map[string]Checkpoint
+	mutex       sync.RWMutex
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{checkpoints: map[string]Checkpoint{}}
+}
+
+func (s *MemoryStore) Get(ctx context.Context, puuid string) (*Checkpoint, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	checkpoint, ok := s.checkpoints[puuid]
+	if !ok {
+		return nil, nil
+	}
+	return &checkpoint, nil
+}
+
+func (s *MemoryStore) Set(ctx context.Context, checkpoint Checkpoint) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.checkpoints[checkpoint.PUUID] = checkpoint
+	return nil
+}
+
+// Store that keeps all checkpoints in a single JSON file.
+//
+// The file is read once when created and rewritten on every Set, through a temporary file renamed in place.
+type FileStore struct {
+	path        string
+	checkpoints map[string]Checkpoint
+	mutex       sync.RWMutex
+}
+
+// Creates a FileStore, loading the checkpoints from path if the file exists.
+func NewFileStore(path string) (*FileStore, error) {
+	store := &FileStore{path: path, checkpoints: map[string]Checkpoint{}}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return store, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := jsonv2.Unmarshal(data, &store.checkpoints); err != nil {
+		return nil, err
+	}
+	return store, nil
+}
+
+func (s *FileStore) Get(ctx context.Context, puuid string) (*Checkpoint, error) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	checkpoint, ok := s.checkpoints[puuid]
+	if !ok {
+		return nil, nil
+	}
+	return &checkpoint, nil
+}
+
+func (s *FileStore) Set(ctx context.Context, checkpoint Checkpoint) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	previous, existed := s.checkpoints[checkpoint.PUUID]
+	s.checkpoints[checkpoint.PUUID] = checkpoint
+
+	if err := s.write(); err != nil {
+		if existed {
+			s.checkpoints[checkpoint.PUUID] = previous
+		} else {
+			delete(s.checkpoints, checkpoint.PUUID)
+		}
+		return err
+	}
+	return nil
+}
+
+func (s *FileStore) write() error {
+	data, err := jsonv2.Marshal(s.checkpoints, jsonv2.Deterministic(true))
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(s.path), filepath.Base(s.path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), s.path)
+}
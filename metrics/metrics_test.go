@@ -0,0 +1,137 @@
+package metrics_test
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+
+	"github.com/Kyagara/equinox/v2"
+	"github.com/Kyagara/equinox/v2/api"
+	"github.com/Kyagara/equinox/v2/metrics"
+	"github.com/Kyagara/equinox/v2/test/util"
+)
+
+// Sends a retried request, a cached request and a rate limited request.
+func runRequests(t *testing.T, m api.Metrics) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", "https://americas.api.riotgames.com/lol/match/v5/matches/BR1_1",
+		httpmock.NewStringResponder(500, `{}`).Then(httpmock.NewStringResponder(200, `{}`)))
+
+	rateLimited := httpmock.NewStringResponder(429, `{}`).HeaderSet(http.Header{"X-Rate-Limit-Type": {"service"}})
+	httpmock.RegisterResponder("GET", "https://americas.api.riotgames.com/lol/match/v5/matches/BR1_2",
+		rateLimited.Then(httpmock.NewStringResponder(404, `{}`)))
+
+	config := util.NewTestEquinoxConfig()
+	config.Retry.MaxRetries = 1
+	config.Metrics = m
+
+	cache, err := equinox.DefaultCache()
+	require.NoError(t, err)
+	client, err := equinox.NewCustomClient(config, nil, cache, equinox.DefaultRateLimit())
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	_, err = client.LOL.MatchV5.ByID(ctx, api.AMERICAS, "BR1_1")
+	require.NoError(t, err)
+	_, err = client.LOL.MatchV5.ByID(ctx, api.AMERICAS, "BR1_1")
+	require.NoError(t, err)
+	_, err = client.LOL.MatchV5.ByID(ctx, api.AMERICAS, "BR1_2")
+	require.ErrorIs(t, err, api.ErrNotFound)
+}
+
+func TestPrometheus(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	m, err := metrics.NewPrometheus(registry)
+	require.NoError(t, err)
+
+	runRequests(t, m)
+
+	expected := `
+# HELP equinox_cache_lookups_total Cache lookups by result, hit or miss.
+# TYPE equinox_cache_lookups_total counter
+equinox_cache_lookups_total{method_id="match-v5.getMatch",result="hit"} 1
+equinox_cache_lookups_total{method_id="match-v5.getMatch",result="miss"} 2
+# HELP equinox_cache_set_bytes_total Bytes written by cache sets, never decreases on deletion, expiry or overwrite. Not the size of the cache.
+# TYPE equinox_cache_set_bytes_total counter
+equinox_cache_set_bytes_total{method_id="match-v5.getMatch"} 2
+# HELP equinox_cache_sets_total Items saved in the cache.
+# TYPE equinox_cache_sets_total counter
+equinox_cache_sets_total{method_id="match-v5.getMatch"} 1
+# HELP equinox_rate_limited_total 429 responses by X-Rate-Limit-Type.
+# TYPE equinox_rate_limited_total counter
+equinox_rate_limited_total{limit_type="service",method_id="match-v5.getMatch",route="americas"} 1
+# HELP equinox_requests_total HTTP requests sent to the Riot API, including retries.
+# TYPE equinox_requests_total counter
+equinox_requests_total{method_id="match-v5.getMatch",route="americas",status="200"} 1
+equinox_requests_total{method_id="match-v5.getMatch",route="americas",status="404"} 1
+equinox_requests_total{method_id="match-v5.getMatch",route="americas",status="429"} 1
+equinox_requests_total{method_id="match-v5.getMatch",route="americas",status="500"} 1
+# HELP equinox_retries_total Retried requests.
+# TYPE equinox_retries_total counter
+equinox_retries_total{method_id="match-v5.getMatch",route="americas"} 2
+`
+	err = testutil.GatherAndCompare(registry, strings.NewReader(expected),
+		"equinox_cache_lookups_total", "equinox_cache_sets_total", "equinox_cache_set_bytes_total", "equinox_rate_limited_total", "equinox_requests_total", "equinox_retries_total")
+	require.NoError(t, err)
+
+	count, err := testutil.GatherAndCount(registry, "equinox_request_duration_seconds", "equinox_ratelimit_wait_seconds")
+	require.NoError(t, err)
+	require.Equal(t, 5, count)
+
+	// Registering twice fails
+	_, err = metrics.NewPrometheus(registry)
+	require.Error(t, err)
+}
+
+func TestOTel(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+
+	m, err := metrics.NewOTel(provider)
+	require.NoError(t, err)
+
+	runRequests(t, m)
+
+	var data metricdata.ResourceMetrics
+	err = reader.Collect(context.Background(), &data)
+	require.NoError(t, err)
+	require.Len(t, data.ScopeMetrics, 1)
+
+	sums := make(map[string]int64)
+	histograms := make(map[string]uint64)
+	for _, instrument := range data.ScopeMetrics[0].Metrics {
+		switch values := instrument.Data.(type) {
+		case metricdata.Sum[int64]:
+			for _, point := range values.DataPoints {
+				sums[instrument.Name] += point.Value
+			}
+		case metricdata.Histogram[float64]:
+			for _, point := range values.DataPoints {
+				histograms[instrument.Name] += point.Count
+			}
+		}
+	}
+
+	require.Equal(t, map[string]int64{
+		"equinox.requests":       4,
+		"equinox.retries":        2,
+		"equinox.rate_limited":   1,
+		"equinox.cache.lookups":  3,
+		"equinox.cache.sets":     1,
+		"equinox.cache.set.bytes": 2,
+	}, sums)
+	require.Equal(t, map[string]uint64{
+		"equinox.request.duration": 4,
+		"equinox.ratelimit.wait":   2,
+	}, histograms)
+}
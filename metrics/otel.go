@@ -0,0 +1,120 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+)
+
+const METER_NAME = "github.com/Kyagara/equinox/v2"
+
+// OpenTelemetry adapter for 'api.Metrics'.
+type OTel struct {
+	requests        metric.Int64Counter
+	requestDuration metric.Float64Histogram
+	rateLimitWait   metric.Float64Histogram
+	retries         metric.Int64Counter
+	rateLimited     metric.Int64Counter
+	cacheLookups    metric.Int64Counter
+	cacheSets       metric.Int64Counter
+	cacheSetBytes   metric.Int64Counter
+	cacheErrors     metric.Int64Counter
+}
+
+// Creates the instruments with a meter from the provider, otel.GetMeterProvider() if nil.
+//
+// Instruments are prefixed with 'equinox.', for example 'equinox.requests'.
+func NewOTel(provider metric.MeterProvider) (*OTel, error) {
+	if provider == nil {
+		provider = otel.GetMeterProvider()
+	}
+	meter := provider.Meter(METER_NAME)
+
+	requests, err1 := meter.Int64Counter("equinox.requests",
+		metric.WithDescription("HTTP requests sent to the Riot API, including retries."))
+	requestDuration, err2 := meter.Float64Histogram("equinox.request.duration",
+		metric.WithDescription("Duration of HTTP requests sent to the Riot API."), metric.WithUnit("s"))
+	rateLimitWait, err3 := meter.Float64Histogram("equinox.ratelimit.wait",
+		metric.WithDescription("Time spent waiting on the rate limiter."), metric.WithUnit("s"))
+	retries, err4 := meter.Int64Counter("equinox.retries",
+		metric.WithDescription("Retried requests."))
+	rateLimited, err5 := meter.Int64Counter("equinox.rate_limited",
+		metric.WithDescription("429 responses by X-Rate-Limit-Type."))
+	cacheLookups, err6 := meter.Int64Counter("equinox.cache.lookups",
+		metric.WithDescription("Cache lookups by result, hit or miss."))
+	cacheSets, err7 := meter.Int64Counter("equinox.cache.sets",
+		metric.WithDescription("Items saved in the cache."))
+	cacheSetBytes, err8 := meter.Int64Counter("equinox.cache.set.bytes",
+		metric.WithDescription("Bytes written by cache sets, never decreases on deletion, expiry or overwrite. Not the size of the cache."), metric.WithUnit("By"))
+	cacheErrors, err9 := meter.Int64Counter("equinox.cache.errors",
+		metric.WithDescription("Failed cache lookups and sets."))
+
+	if err := errors.Join(err1, err2, err3, err4, err5, err6, err7, err8, err9); err != nil {
+		return nil, err
+	}
+
+	return &OTel{
+		requests:        requests,
+		requestDuration: requestDuration,
+		rateLimitWait:   rateLimitWait,
+		retries:         retries,
+		rateLimited:     rateLimited,
+		cacheLookups:    cacheLookups,
+		cacheSets:       cacheSets,
+		cacheSetBytes:   cacheSetBytes,
+		cacheErrors:     cacheErrors,
+	}, nil
+}
+
+func (o *OTel) ObserveRequest(methodID string, route string, statusCode int, duration time.Duration) {
+	attributes := metric.WithAttributes(
+		attribute.String("method_id", methodID),
+		attribute.String("route", route),
+		attribute.Int("status", statusCode),
+	)
+	o.requests.Add(context.Background(), 1, attributes)
+	o.requestDuration.Record(context.Background(), duration.Seconds(), attributes)
+}
+
+func (o *OTel) ObserveRateLimitWait(methodID string, route string, wait time.Duration) {
+	o.rateLimitWait.Record(context.Background(), wait.Seconds(), metric.WithAttributes(
+		attribute.String("method_id", methodID),
+		attribute.String("route", route),
+	))
+}
+
+func (o *OTel) IncRetry(methodID string, route string) {
+	o.retries.Add(context.Background(), 1, metric.WithAttributes(
+		attribute.String("method_id", methodID),
+		attribute.String("route", route),
+	))
+}
+
+func (o *OTel) IncRateLimited(methodID string, route string, limitType string) {
+	o.rateLimited.Add(context.Background(), 1, metric.WithAttributes(
+		attribute.String("method_id", methodID),
+		attribute.String("route", route),
+		attribute.String("limit_type", limitType),
+	))
+}
+
+func (o *OTel) IncCacheLookup(methodID string, hit bool) {
+	o.cacheLookups.Add(context.Background(), 1, metric.WithAttributes(
+		attribute.String("method_id", methodID),
+		attribute.String("result", cacheResult(hit)),
+	))
+}
+
+func (o *OTel) IncCacheSet(methodID string, bytes int) {
+	attributes := metric.WithAttributes(attribute.String("method_id", methodID))
+	o.cacheSets.Add(context.Background(), 1, attributes)
+	o.cacheSetBytes.Add(context.Background(), int64(bytes), attributes)
+}
+
+func (o *OTel) IncCacheError(methodID string) {
+	o.cacheErrors.Add(context.Background(), 1, metric.WithAttributes(attribute.String("method_id", methodID)))
+}
@@ -0,0 +1,132 @@
+// Prometheus and OpenTelemetry adapters for api.Metrics, a separate module so equinox doesn't depend on Prometheus or the OpenTelemetry metric SDK.
+//
+// Requires equinox v2.1.0 or later, develop against the local tree with a go.work using '.' and './metrics', see the CI workflow.
+package metrics
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const NAMESPACE = "equinox"
+
+// Prometheus adapter for 'api.Metrics'.
+type Prometheus struct {
+	requests        *prometheus.CounterVec
+	requestDuration *prometheus.HistogramVec
+	rateLimitWait   *prometheus.HistogramVec
+	retries         *prometheus.CounterVec
+	rateLimited     *prometheus.CounterVec
+	cacheLookups    *prometheus.CounterVec
+	cacheSets       *prometheus.CounterVec
+	cacheSetBytes   *prometheus.CounterVec
+	cacheErrors     *prometheus.CounterVec
+}
+
+// Creates and registers the collectors in the registerer, prometheus.DefaultRegisterer if nil.
+//
+// Metrics are prefixed with 'equinox_', for example 'equinox_requests_total'.
+func NewPrometheus(registerer prometheus.Registerer) (*Prometheus, error) {
+	if registerer == nil {
+		registerer = prometheus.DefaultRegisterer
+	}
+
+	p := &Prometheus{
+		requests: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: NAMESPACE,
+			Name:      "requests_total",
+			Help:      "HTTP requests sent to the Riot API, including retries.",
+		}, []string{"method_id", "route", "status"}),
+		requestDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: NAMESPACE,
+			Name:      "request_duration_seconds",
+			Help:      "Duration of HTTP requests sent to the Riot API.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method_id", "route", "status"}),
+		rateLimitWait: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: NAMESPACE,
+			Name:      "ratelimit_wait_seconds",
+			Help:      "Time spent waiting on the rate limiter.",
+			Buckets:   []float64{0.001, 0.01, 0.1, 0.5, 1, 2.5, 5, 10, 30, 60, 120},
+		}, []string{"method_id", "route"}),
+		retries: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: NAMESPACE,
+			Name:      "retries_total",
+			Help:      "Retried requests.",
+		}, []string{"method_id", "route"}),
+		rateLimited: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: NAMESPACE,
+			Name:      "rate_limited_total",
+			Help:      "429 responses by X-Rate-Limit-Type.",
+		}, []string{"method_id", "route", "limit_type"}),
+		cacheLookups: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: NAMESPACE,
+			Name:      "cache_lookups_total",
+			Help:      "Cache lookups by result, hit or miss.",
+		}, []string{"method_id", "result"}),
+		cacheSets: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: NAMESPACE,
+			Name:      "cache_sets_total",
+			Help:      "Items saved in the cache.",
+		}, []string{"method_id"}),
+		cacheSetBytes: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: NAMESPACE,
+			Name:      "cache_set_bytes_total",
+			Help:      "Bytes written by cache sets, never decreases on deletion, expiry or overwrite. Not the size of the cache.",
+		}, []string{"method_id"}),
+		cacheErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: NAMESPACE,
+			Name:      "cache_errors_total",
+			Help:      "Failed cache lookups and sets.",
+		}, []string{"method_id"}),
+	}
+
+	collectors := []prometheus.Collector{p.requests, p.requestDuration, p.rateLimitWait, p.retries, p.rateLimited, p.cacheLookups, p.cacheSets, p.cacheSetBytes, p.cacheErrors}
+	for _, collector := range collectors {
+		if err := registerer.Register(collector); err != nil {
+			return nil, err
+		}
+	}
+
+	return p, nil
+}
+
+func (p *Prometheus) ObserveRequest(methodID string, route string, statusCode int, duration time.Duration) {
+	status := strconv.Itoa(statusCode)
+	p.requests.WithLabelValues(methodID, route, status).Inc()
+	p.requestDuration.WithLabelValues(methodID, route, status).Observe(duration.Seconds())
+}
+
+func (p *Prometheus) ObserveRateLimitWait(methodID string, route string, wait time.Duration) {
+	p.rateLimitWait.WithLabelValues(methodID, route).Observe(wait.Seconds())
+}
+
+func (p *Prometheus) IncRetry(methodID string, route string) {
+	p.retries.WithLabelValues(methodID, route).Inc()
+}
+
+func (p *Prometheus) IncRateLimited(methodID string, route string, limitType string) {
+	p.rateLimited.WithLabelValues(methodID, route, limitType).Inc()
+}
+
+func (p *Prometheus) IncCacheLookup(methodID string, hit bool) {
+	p.cacheLookups.WithLabelValues(methodID, cacheResult(hit)).Inc()
+}
+
+func (p *Prometheus) IncCacheSet(methodID string, bytes int) {
+	p.cacheSets.WithLabelValues(methodID).Inc()
+	p.cacheSetBytes.WithLabelValues(methodID).Add(float64(bytes))
+}
+
+func (p *Prometheus) IncCacheError(methodID string) {
+	p.cacheErrors.WithLabelValues(methodID).Inc()
+}
+
+func cacheResult(hit bool) string {
+	if hit {
+		return "hit"
+	}
+	return "miss"
+}
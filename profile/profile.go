@@ -0,0 +1,121 @@
+// This package aggregates the most common lookups for a League of Legends player, starting from a Riot ID.
+package profile
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/Kyagara/equinox/v2"
+	"github.com/Kyagara/equinox/v2/api"
+	"github.com/Kyagara/equinox/v2/clients/lol"
+	"github.com/Kyagara/equinox/v2/clients/riot"
+)
+
+var (
+	ErrInvalidRiotID = errors.New("riot id must be in the format 'gameName#tagLine'")
+	ErrUnknownRegion = errors.New("unknown active region")
+)
+
+type Options struct {
+	// Platform of the player, if empty it is looked up with AccountV1.ActiveRegion.
+	Platform lol.PlatformRoute
+	// Number of champion masteries to return, 0 uses the Riot API default.
+	MasteryCount int
+}
+
+type Profile struct {
+	Account  riot.AccountV1DTO
+	Platform lol.PlatformRoute
+	Summoner *lol.SummonerV4DTO
+	Entries  []lol.LeagueEntryV4DTO
+	// Top champion masteries, highest first.
+	Masteries []lol.ChampionMasteryV4DTO
+	// nil if the player is not in a game.
+	CurrentGame *lol.SpectatorCurrentGameInfoV5DTO
+	// Errors of the requests that failed after the account was found, keyed by endpoint method, e.g. "SummonerV4.ByPUUID".
+	Errors map[string]error
+}
+
+// Returns the profile of a player from a Riot ID ('gameName#tagLine'), route is used for the AccountV1 requests.
+//
+// The account and its platform are required, an error is returned if either can't be found.
+// The remaining requests are made concurrently and their failures are stored in Profile.Errors.
+func Get(ctx context.Context, client *equinox.Equinox, route api.RegionalRoute, riotID string, opts *Options) (*Profile, error) {
+	gameName, tagLine, ok := strings.Cut(riotID, "#")
+	if !ok || gameName == "" || tagLine == "" {
+		return nil, ErrInvalidRiotID
+	}
+
+	if opts == nil {
+		opts = &Options{}
+	}
+
+	account, err := client.Riot.AccountV1.ByRiotID(ctx, route, gameName, tagLine)
+	if err != nil {
+		return nil, err
+	}
+
+	platform := opts.Platform
+	if platform == "" {
+		region, err := client.Riot.AccountV1.ActiveRegion(ctx, route, "lol", account.PUUID)
+		if err != nil {
+			return nil, err
+		}
+		platform, err = lol.ParsePlatformRoute(region.Region)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrUnknownRegion, err)
+		}
+	}
+
+	profile := &Profile{Account: *account, Platform: platform, Errors: map[string]error{}}
+	puuid := account.PUUID
+
+	var mutex sync.Mutex
+	var wg sync.WaitGroup
+	run := func(method string, fn func() error) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := fn(); err != nil {
+				mutex.Lock()
+				profile.Errors[method] = err
+				mutex.Unlock()
+			}
+		}()
+	}
+
+	run("SummonerV4.ByPUUID", func() (err error) {
+		profile.Summoner, err = client.LOL.SummonerV4.ByPUUID(ctx, platform, puuid)
+		return err
+	})
+
+	run("LeagueV4.EntriesByPUUID", func() (err error) {
+		profile.Entries, err = client.LOL.LeagueV4.EntriesByPUUID(ctx, platform, puuid)
+		return err
+	})
+
+	run("ChampionMasteryV4.TopMasteriesByPUUID", func() (err error) {
+		var masteryOpts *lol.ChampionMasteryV4TopMasteriesByPUUIDOptions
+		if opts.MasteryCount > 0 {
+			masteryOpts = &lol.ChampionMasteryV4TopMasteriesByPUUIDOptions{Count: &opts.MasteryCount}
+		}
+		profile.Masteries, err = client.LOL.ChampionMasteryV4.TopMasteriesByPUUIDWithOptions(ctx, platform, puuid, masteryOpts)
+		return err
+	})
+
+	run("SpectatorV5.CurrentGameInfoByPUUID", func() error {
+		game, err := client.LOL.SpectatorV5.CurrentGameInfoByPUUID(ctx, platform, puuid)
+		if errors.Is(err, api.ErrNotFound) {
+			// Not in a game
+			return nil
+		}
+		profile.CurrentGame = game
+		return err
+	})
+
+	wg.Wait()
+	return profile, nil
+}
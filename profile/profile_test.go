@@ -0,0 +1,66 @@
+package profile_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/Kyagara/equinox/v2"
+	"github.com/Kyagara/equinox/v2/api"
+	"github.com/Kyagara/equinox/v2/clients/lol"
+	"github.com/Kyagara/equinox/v2/profile"
+	"github.com/Kyagara/equinox/v2/test/util"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGet(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", "https://europe.api.riotgames.com/riot/account/v1/accounts/by-riot-id/Name/TAG",
+		httpmock.NewStringResponder(200, `{"puuid":"puuid","gameName":"Name","tagLine":"TAG"}`))
+	httpmock.RegisterResponder("GET", "https://europe.api.riotgames.com/riot/account/v1/region/by-game/lol/by-puuid/puuid",
+		httpmock.NewStringResponder(200, `{"puuid":"puuid","game":"lol","region":"EUW1"}`))
+	httpmock.RegisterResponder("GET", "https://euw1.api.riotgames.com/lol/summoner/v4/summoners/by-puuid/puuid",
+		httpmock.NewStringResponder(200, `{"puuid":"puuid","summonerLevel":100}`))
+	httpmock.RegisterResponder("GET", "https://euw1.api.riotgames.com/lol/league/v4/entries/by-puuid/puuid",
+		httpmock.NewStringResponder(200, `[{"puuid":"puuid","tier":"GOLD","rank":"II"}]`))
+	httpmock.RegisterResponderWithQuery("GET", "https://euw1.api.riotgames.com/lol/champion-mastery/v4/champion-masteries/by-puuid/puuid/top", "count=1",
+		httpmock.NewStringResponder(200, `[{"championId":1}]`))
+	httpmock.RegisterResponder("GET", "https://euw1.api.riotgames.com/lol/spectator/v5/active-games/by-summoner/puuid",
+		httpmock.NewStringResponder(404, `{}`))
+
+	config := util.NewTestEquinoxConfig()
+	client, err := equinox.NewCustomClient(config, nil, nil, nil)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+
+	p, err := profile.Get(ctx, client, api.EUROPE, "Name#TAG", &profile.Options{MasteryCount: 1})
+	require.NoError(t, err)
+	require.Equal(t, "puuid", p.Account.PUUID)
+	require.Equal(t, lol.EUW1, p.Platform)
+	require.Equal(t, 100, p.Summoner.SummonerLevel)
+	require.Len(t, p.Entries, 1)
+	require.Len(t, p.Masteries, 1)
+	require.Nil(t, p.CurrentGame)
+	require.Empty(t, p.Errors)
+
+	// Platform provided, partial failure
+	httpmock.RegisterResponder("GET", "https://euw1.api.riotgames.com/lol/league/v4/entries/by-puuid/puuid",
+		httpmock.NewStringResponder(500, `{}`))
+	httpmock.RegisterResponder("GET", "https://euw1.api.riotgames.com/lol/champion-mastery/v4/champion-masteries/by-puuid/puuid/top",
+		httpmock.NewStringResponder(200, `[]`))
+	httpmock.ZeroCallCounters()
+
+	p, err = profile.Get(ctx, client, api.EUROPE, "Name#TAG", &profile.Options{Platform: lol.EUW1})
+	require.NoError(t, err)
+	require.NotNil(t, p.Summoner)
+	require.Empty(t, p.Entries)
+	require.Len(t, p.Errors, 1)
+	require.ErrorIs(t, p.Errors["LeagueV4.EntriesByPUUID"], api.ErrInternalServer)
+	require.Zero(t, httpmock.GetCallCountInfo()["GET https://europe.api.riotgames.com/riot/account/v1/region/by-game/lol/by-puuid/puuid"])
+
+	_, err = profile.Get(ctx, client, api.EUROPE, "Name", nil)
+	require.Equal(t, profile.ErrInvalidRiotID, err)
+}
@@ -5,6 +5,8 @@ import (
 	"time"
 
 	"github.com/rs/zerolog"
+
+	"github.com/Kyagara/equinox/v2/api"
 )
 
 type Bucket struct {
@@ -19,6 +21,7 @@ type Bucket struct {
 	// Time interval in seconds.
 	Interval         time.Duration
 	IntervalOverhead time.Duration
+	clock            api.Clock
 	mutex            sync.Mutex
 }
 
@@ -26,22 +29,29 @@ func (b *Bucket) MarshalZerologObject(encoder *zerolog.Event) {
 	encoder.Int("t", b.Tokens).Int("l", b.Limit).Dur("i", b.Interval).Time("n", b.Next)
 }
 
+// Creates a new Bucket that resets after interval + intervalOverhead, using api.SystemClock.
 func NewBucket(interval time.Duration, intervalOverhead time.Duration, baseLimit int, limit int, tokens int) *Bucket {
+	return NewBucketWithClock(api.SystemClock{}, interval, intervalOverhead, baseLimit, limit, tokens)
+}
+
+// Same as NewBucket, using the given clock for resets.
+func NewBucketWithClock(clock api.Clock, interval time.Duration, intervalOverhead time.Duration, baseLimit int, limit int, tokens int) *Bucket {
 	return &Bucket{
-		Next:             time.Now().Add(interval + intervalOverhead),
+		Next:             clock.Now().Add(interval + intervalOverhead),
 		Tokens:           tokens,
 		BaseLimit:        baseLimit,
 		Limit:            limit,
 		Interval:         interval,
 		IntervalOverhead: intervalOverhead,
+		clock:            clock,
 		mutex:            sync.Mutex{},
 	}
 }
 
 // Checks if the next reset is in the past, and if so, reset the bucket and set the next reset.
 func (b *Bucket) Check() {
-	now := time.Now()
-	if b.Next.Before(now) {
+	now := b.now()
+	if !now.Before(b.Next) {
 		b.Tokens = 0
 		b.Next = now.Add(b.Interval + b.IntervalOverhead)
 	}
@@ -56,3 +66,10 @@ func (b *Bucket) IsRateLimited() bool {
 	b.Tokens++
 	return b.Tokens >= b.Limit
 }
+
+func (b *Bucket) now() time.Time {
+	if b.clock == nil {
+		return time.Now()
+	}
+	return b.clock.Now()
+}
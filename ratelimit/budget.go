@@ -0,0 +1,95 @@
+package ratelimit
+
+import (
+	"time"
+)
+
+// Requests left in a route and method before Reserve blocks.
+type Budget struct {
+	// Requests that can be reserved without waiting, -1 if no limits were received yet.
+	Remaining int
+	// Time until the most restrictive bucket resets, 0 if Remaining is not 0.
+	Reset time.Duration
+	// Requests per second allowed by the most restrictive bucket, 0 if no limits were received yet.
+	Rate float64
+}
+
+// Optional interface for a Store, required by RateLimit.Budget.
+type BudgetReporter interface {
+	// Returns the requests left for the App and Method buckets in a route, without reserving any.
+	Budget(route string, methodID string, isRSO bool) Budget
+}
+
+// Returns the requests left for the App and Method buckets in a route, without reserving any.
+//
+// Remaining is -1 if the rate limit is disabled, the Store doesn't implement BudgetReporter or no limits were received yet.
+func (r *RateLimit) Budget(route string, methodID string, isRSO bool) Budget {
+	unknown := Budget{Remaining: -1}
+	if !r.Enabled {
+		return unknown
+	}
+	reporter, ok := r.store.(BudgetReporter)
+	if !ok {
+		return unknown
+	}
+	return reporter.Budget(route, methodID, isRSO)
+}
+
+func (r *InternalRateLimitStore) Budget(route string, methodID string, isRSO bool) Budget {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	budget := Budget{Remaining: -1}
+	limits, ok := r.Route[route]
+	if !ok {
+		return budget
+	}
+
+	now := r.clock().Now()
+	if !isRSO {
+		limits.App.budget(now, &budget)
+	}
+	if methods, ok := limits.Methods[methodID]; ok {
+		methods.budget(now, &budget)
+	}
+	return budget
+}
+
+// Lowers the budget to the most restrictive bucket in the Limit.
+func (l *Limit) budget(now time.Time, budget *Budget) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	if l.RetryAfter > 0 {
+		budget.Remaining = 0
+		budget.Reset = max(budget.Reset, l.RetryAfter)
+	}
+
+	for _, bucket := range l.Buckets {
+		bucket.mutex.Lock()
+		if bucket.BaseLimit == 0 || bucket.Interval <= 0 {
+			bucket.mutex.Unlock()
+			continue
+		}
+
+		// Same as IsRateLimited, a bucket blocks once Tokens reaches Limit
+		tokens, reset := bucket.Tokens, bucket.Next.Sub(now)
+		if reset <= 0 {
+			tokens, reset = 0, 0
+		}
+		remaining := max(bucket.Limit-1-tokens, 0)
+
+		if budget.Remaining < 0 || remaining < budget.Remaining {
+			budget.Remaining = remaining
+		}
+		if remaining == 0 {
+			budget.Reset = max(budget.Reset, reset)
+		}
+
+		rate := float64(bucket.Limit) / (bucket.Interval + bucket.IntervalOverhead).Seconds()
+		if budget.Rate == 0 || rate < budget.Rate {
+			budget.Rate = rate
+		}
+		bucket.mutex.Unlock()
+	}
+}
@@ -0,0 +1,66 @@
+package ratelimit_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/Kyagara/equinox/v2/ratelimit"
+	"github.com/Kyagara/equinox/v2/test/util"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBudget(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	logger := util.NewTestLogger()
+	r, clock := newTestRateLimit()
+
+	// No limits received yet
+	require.Equal(t, ratelimit.Budget{Remaining: -1}, r.Budget("route", "method", false))
+
+	err := r.Reserve(ctx, logger, "route", "method", false)
+	require.NoError(t, err)
+	require.Equal(t, ratelimit.Budget{Remaining: -1}, r.Budget("route", "method", false))
+
+	headers := http.Header{
+		ratelimit.APP_RATE_LIMIT_HEADER:          []string{"20:1,100:120"},
+		ratelimit.APP_RATE_LIMIT_COUNT_HEADER:    []string{"1:1,1:120"},
+		ratelimit.METHOD_RATE_LIMIT_HEADER:       []string{"5:10"},
+		ratelimit.METHOD_RATE_LIMIT_COUNT_HEADER: []string{"1:10"},
+	}
+	err = r.Update(ctx, logger, "route", "method", headers, 0)
+	require.NoError(t, err)
+
+	// The method bucket allows 4 requests, 5 * 0.99, and blocks once 4 tokens are used
+	budget := r.Budget("route", "method", false)
+	require.Equal(t, 2, budget.Remaining)
+	require.Zero(t, budget.Reset)
+	require.InDelta(t, 4.0/11.0, budget.Rate, 0.0001)
+
+	// RSO requests skip the App buckets
+	budget = r.Budget("route", "method", true)
+	require.Equal(t, 2, budget.Remaining)
+
+	for range 2 {
+		err = r.Reserve(ctx, logger, "route", "method", false)
+		require.NoError(t, err)
+	}
+	budget = r.Budget("route", "method", false)
+	require.Equal(t, 0, budget.Remaining)
+	require.Equal(t, 11*time.Second, budget.Reset)
+
+	// Reset is in the past
+	clock.Advance(11 * time.Second)
+	budget = r.Budget("route", "method", false)
+	require.Equal(t, 3, budget.Remaining)
+
+	// Unknown method, only the App buckets are known, the 1 second bucket was also reset
+	budget = r.Budget("route", "other", false)
+	require.Equal(t, 18, budget.Remaining)
+
+	r.Enabled = false
+	require.Equal(t, ratelimit.Budget{Remaining: -1}, r.Budget("route", "method", false))
+}
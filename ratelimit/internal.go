@@ -7,19 +7,32 @@ import (
 	"time"
 
 	"github.com/rs/zerolog"
+
+	"github.com/Kyagara/equinox/v2/api"
 )
 
 type InternalRateLimitStore struct {
 	Route            map[string]*Limits
 	limitUsageFactor float64
 	intervalOverhead time.Duration
-	mutex            sync.Mutex
+	// Used to read the Listeners and Clock, they can be set after the store is created.
+	owner *RateLimit
+	mutex sync.Mutex
+}
+
+func (r *InternalRateLimitStore) listeners() *api.Listeners {
+	if r.owner == nil {
+		return nil
+	}
+	return r.owner.Listeners
+}
+
+func (r *InternalRateLimitStore) clock() api.Clock {
+	return r.owner.clock()
 }
 
 func (r *InternalRateLimitStore) Reserve(ctx context.Context, logger zerolog.Logger, route string, methodID string, isRSO bool) error {
 	r.mutex.Lock()
-	defer r.mutex.Unlock()
-
 	limits, ok := r.Route[route]
 	if !ok {
 		limits = NewLimits()
@@ -32,19 +45,37 @@ func (r *InternalRateLimitStore) Reserve(ctx context.Context, logger zerolog.Log
 		limits.Methods[methodID] = methods
 	}
 
+	app := limits.App
+	clock := r.clock()
+	listeners := r.listeners()
+	r.mutex.Unlock()
+
+	// Waits and OnRateLimited only hold the limit being checked, other routes and methods are not blocked
 	if !isRSO {
-		if err := limits.App.CheckBuckets(ctx, logger, route); err != nil {
+		if err := app.checkBuckets(ctx, clock, logger, route, methodID, listeners); err != nil {
 			return err
 		}
 	}
 
-	return methods.CheckBuckets(ctx, logger, route)
+	return methods.checkBuckets(ctx, clock, logger, route, methodID, listeners)
 }
 
 func (r *InternalRateLimitStore) Update(ctx context.Context, logger zerolog.Logger, route string, methodID string, headers http.Header, retryAfter time.Duration) error {
 	r.mutex.Lock()
-	defer r.mutex.Unlock()
+	events := r.update(logger, route, methodID, headers, retryAfter)
+	listeners := r.listeners()
+	r.mutex.Unlock()
 
+	// Emitted after unlocking, listeners can't block the store
+	for _, event := range events {
+		listeners.EmitLimitsChanged(event)
+	}
+	return nil
+}
+
+// Updates the limits of a route, returns the events to emit once the store is unlocked.
+func (r *InternalRateLimitStore) update(logger zerolog.Logger, route string, methodID string, headers http.Header, retryAfter time.Duration) []api.LimitsChangedEvent {
+	var events []api.LimitsChangedEvent
 	limits := r.Route[route]
 
 	// If rate limited, set RetryAfter delay based on the rate limit type
@@ -55,6 +86,13 @@ func (r *InternalRateLimitStore) Update(ctx context.Context, logger zerolog.Logg
 		} else {
 			limits.Methods[methodID].SetRetryAfter(retryAfter)
 		}
+
+		events = append(events, api.LimitsChangedEvent{
+			Route:      route,
+			MethodID:   methodID,
+			LimitType:  limitType,
+			RetryAfter: retryAfter,
+		})
 	}
 
 	appLimitHeader := headers.Get(APP_RATE_LIMIT_HEADER)
@@ -62,17 +100,23 @@ func (r *InternalRateLimitStore) Update(ctx context.Context, logger zerolog.Logg
 
 	if !limits.App.LimitsMatch(appLimitHeader) {
 		countHeader := headers.Get(APP_RATE_LIMIT_COUNT_HEADER)
-		newLimit := ParseHeaders(APP_RATE_LIMIT_TYPE, appLimitHeader, countHeader, r.limitUsageFactor, r.intervalOverhead)
+		newLimit := ParseHeadersWithClock(r.clock(), APP_RATE_LIMIT_TYPE, appLimitHeader, countHeader, r.limitUsageFactor, r.intervalOverhead)
 		limits.App = newLimit
 		logger.Debug().Str("route", route).Object("limit", newLimit).Msg("New application limit")
+		if appLimitHeader != "" {
+			events = append(events, newLimit.changedEvent(route, methodID))
+		}
 	}
 
 	if !limits.Methods[methodID].LimitsMatch(methodLimitHeader) {
 		countHeader := headers.Get(METHOD_RATE_LIMIT_COUNT_HEADER)
-		newLimit := ParseHeaders(METHOD_RATE_LIMIT_TYPE, methodLimitHeader, countHeader, r.limitUsageFactor, r.intervalOverhead)
+		newLimit := ParseHeadersWithClock(r.clock(), METHOD_RATE_LIMIT_TYPE, methodLimitHeader, countHeader, r.limitUsageFactor, r.intervalOverhead)
 		limits.Methods[methodID] = newLimit
 		logger.Debug().Str("route", route).Object("limit", newLimit).Msg("New method limit")
+		if methodLimitHeader != "" {
+			events = append(events, newLimit.changedEvent(route, methodID))
+		}
 	}
 
-	return nil
+	return events
 }
@@ -8,11 +8,21 @@ import (
 	"time"
 
 	"github.com/Kyagara/equinox/v2/api"
+	"github.com/Kyagara/equinox/v2/equinoxtest"
 	"github.com/Kyagara/equinox/v2/ratelimit"
 	"github.com/Kyagara/equinox/v2/test/util"
 	"github.com/stretchr/testify/require"
 )
 
+// Returns a rate limiter using a clock that advances when waiting, tests don't sleep.
+func newTestRateLimit() (*ratelimit.RateLimit, *equinoxtest.Clock) {
+	clock := equinoxtest.NewClock(time.Now())
+	clock.AutoAdvance = true
+	r := ratelimit.NewInternalRateLimit(0.99, time.Second)
+	r.Clock = clock
+	return r, clock
+}
+
 func TestNewInternalRateLimit(t *testing.T) {
 	t.Parallel()
 
@@ -111,12 +121,12 @@ func TestReserveAndUpdate(t *testing.T) {
 		Logger:   util.NewTestLogger(),
 	}
 
-	// These tests should take around 2 seconds each
 	t.Run("app and method rate limited", func(t *testing.T) {
 		t.Parallel()
 		ctx := context.Background()
 
-		r := ratelimit.NewInternalRateLimit(0.99, time.Second)
+		r, clock := newTestRateLimit()
+		start := clock.Now()
 
 		// Initializing the rate limit
 		err := r.Reserve(ctx, equinoxReq.Logger, equinoxReq.Route, equinoxReq.MethodID, false)
@@ -147,14 +157,17 @@ func TestReserveAndUpdate(t *testing.T) {
 		// Method rate limited
 		err = r.Reserve(ctx, equinoxReq.Logger, equinoxReq.Route, equinoxReq.MethodID, false)
 		require.NoError(t, err)
+
+		// 3 seconds for each limit, 2 for the interval and 1 for the interval overhead
+		require.Equal(t, 6*time.Second, clock.Now().Sub(start))
 	})
 
-	// Should take 3 seconds, 2 seconds for the app and 1 for the interval overhead
 	t.Run("waiting bucket to reset", func(t *testing.T) {
 		t.Parallel()
 		ctx := context.Background()
 
-		r := ratelimit.NewInternalRateLimit(0.99, time.Second)
+		r, clock := newTestRateLimit()
+		start := clock.Now()
 
 		// Initializing the rate limit
 		err := r.Reserve(ctx, equinoxReq.Logger, equinoxReq.Route, equinoxReq.MethodID, false)
@@ -171,14 +184,17 @@ func TestReserveAndUpdate(t *testing.T) {
 
 		err = r.Reserve(ctx, equinoxReq.Logger, equinoxReq.Route, equinoxReq.MethodID, false)
 		require.NoError(t, err)
+
+		// 2 seconds for the app interval and 1 for the interval overhead
+		require.Equal(t, 3*time.Second, clock.Now().Sub(start))
 	})
 
-	// Should take 5 seconds, 2 for each rate limit and 1 for the interval overhead
 	t.Run("waiting retry after", func(t *testing.T) {
 		t.Parallel()
 		ctx := context.Background()
 
-		r := ratelimit.NewInternalRateLimit(0.99, time.Second)
+		r, clock := newTestRateLimit()
+		start := clock.Now()
 
 		// Initializing the rate limit
 		err := r.Reserve(ctx, equinoxReq.Logger, equinoxReq.Route, equinoxReq.MethodID, false)
@@ -224,13 +240,16 @@ func TestReserveAndUpdate(t *testing.T) {
 
 		err = r.Reserve(ctx, equinoxReq.Logger, equinoxReq.Route, equinoxReq.MethodID, false)
 		require.NoError(t, err)
+
+		// 2 seconds for each RetryAfter and 1 left in the new method bucket
+		require.Equal(t, 5*time.Second, clock.Now().Sub(start))
 	})
 
 	t.Run("CheckBuckets failed in reserve because of deadline", func(t *testing.T) {
 		t.Parallel()
 		ctx := context.Background()
 
-		r := ratelimit.NewInternalRateLimit(0.99, time.Second)
+		r, clock := newTestRateLimit()
 
 		// Initializing the rate limit
 		err := r.Reserve(ctx, equinoxReq.Logger, equinoxReq.Route, equinoxReq.MethodID, false)
@@ -244,9 +263,120 @@ func TestReserveAndUpdate(t *testing.T) {
 		err = r.Update(ctx, equinoxReq.Logger, equinoxReq.Route, equinoxReq.MethodID, headers, time.Duration(0))
 		require.NoError(t, err)
 
-		ctx, c := context.WithDeadline(ctx, time.Now().Add(time.Second))
+		ctx, c := context.WithDeadline(ctx, clock.Now().Add(time.Second))
 		defer c()
 		err = r.Reserve(ctx, equinoxReq.Logger, equinoxReq.Route, equinoxReq.MethodID, false)
-		require.Error(t, err)
+		require.ErrorIs(t, err, ratelimit.ErrContextDeadlineExceeded)
 	})
 }
+
+func TestListeners(t *testing.T) {
+	t.Parallel()
+
+	var rateLimited []api.RateLimitedEvent
+	var changed []api.LimitsChangedEvent
+
+	clock := equinoxtest.NewClock(time.Now())
+	clock.AutoAdvance = true
+	start := clock.Now()
+
+	r := ratelimit.NewInternalRateLimit(0.99, 0)
+	r.Clock = clock
+	r.Listeners = &api.Listeners{
+		OnRateLimited:   func(event api.RateLimitedEvent) { rateLimited = append(rateLimited, event) },
+		OnLimitsChanged: func(event api.LimitsChangedEvent) { changed = append(changed, event) },
+	}
+
+	ctx := context.Background()
+	logger := util.NewTestLogger()
+
+	err := r.Reserve(ctx, logger, "route", "method", false)
+	require.NoError(t, err)
+
+	headers := http.Header{
+		ratelimit.APP_RATE_LIMIT_HEADER:          []string{"100:1"},
+		ratelimit.APP_RATE_LIMIT_COUNT_HEADER:    []string{"1:1"},
+		ratelimit.METHOD_RATE_LIMIT_HEADER:       []string{"1:1"},
+		ratelimit.METHOD_RATE_LIMIT_COUNT_HEADER: []string{"1:1"},
+	}
+
+	err = r.Update(ctx, logger, "route", "method", headers, 0)
+	require.NoError(t, err)
+	require.Len(t, changed, 2)
+	require.Equal(t, ratelimit.APP_RATE_LIMIT_TYPE, changed[0].LimitType)
+	require.Equal(t, []api.BucketLimit{{BaseLimit: 100, Limit: 99, Interval: time.Second}}, changed[0].Buckets)
+	require.Equal(t, ratelimit.METHOD_RATE_LIMIT_TYPE, changed[1].LimitType)
+
+	// Same limits, only RetryAfter is set
+	headers.Set(ratelimit.RATE_LIMIT_TYPE_HEADER, ratelimit.METHOD_RATE_LIMIT_TYPE)
+	err = r.Update(ctx, logger, "route", "method", headers, 100*time.Millisecond)
+	require.NoError(t, err)
+	require.Len(t, changed, 3)
+	require.Nil(t, changed[2].Buckets)
+	require.Equal(t, 100*time.Millisecond, changed[2].RetryAfter)
+
+	// Waits the RetryAfter and the method bucket
+	err = r.Reserve(ctx, logger, "route", "method", false)
+	require.NoError(t, err)
+	require.Len(t, rateLimited, 2)
+	require.True(t, rateLimited[0].RetryAfter)
+	require.Equal(t, 100*time.Millisecond, rateLimited[0].Wait)
+	require.False(t, rateLimited[1].RetryAfter)
+	require.Equal(t, "method", rateLimited[1].MethodID)
+	require.Equal(t, ratelimit.METHOD_RATE_LIMIT_TYPE, rateLimited[1].LimitType)
+	require.Equal(t, 1, rateLimited[1].Limit)
+	require.Equal(t, time.Second, rateLimited[1].Interval)
+	require.Equal(t, 900*time.Millisecond, rateLimited[1].Wait)
+	require.Equal(t, time.Second, clock.Now().Sub(start))
+}
+
+func TestListenersCallingStore(t *testing.T) {
+	t.Parallel()
+
+	clock := equinoxtest.NewClock(time.Now())
+	clock.AutoAdvance = true
+
+	r := ratelimit.NewInternalRateLimit(0.99, 0)
+	r.Clock = clock
+
+	ctx := context.Background()
+	logger := util.NewTestLogger()
+
+	// Listeners using the store for another route would deadlock if called while it is locked
+	var changed, rateLimited []error
+	r.Listeners = &api.Listeners{
+		OnLimitsChanged: func(event api.LimitsChangedEvent) {
+			changed = append(changed, r.Reserve(ctx, logger, "other", "method", false))
+		},
+		OnRateLimited: func(event api.RateLimitedEvent) {
+			rateLimited = append(rateLimited, r.Reserve(ctx, logger, "other", "method", false))
+		},
+	}
+
+	headers := http.Header{
+		ratelimit.METHOD_RATE_LIMIT_HEADER:       []string{"1:1"},
+		ratelimit.METHOD_RATE_LIMIT_COUNT_HEADER: []string{"1:1"},
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		err := r.Reserve(ctx, logger, "route", "method", false)
+		if err == nil {
+			err = r.Update(ctx, logger, "route", "method", headers, 0)
+		}
+		if err == nil {
+			err = r.Reserve(ctx, logger, "route", "method", false)
+		}
+		done <- err
+	}()
+
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("listener calling the store deadlocked")
+	}
+
+	require.Equal(t, []error{nil}, changed)
+	require.Equal(t, []error{nil}, rateLimited)
+}
@@ -7,6 +7,8 @@ import (
 	"time"
 
 	"github.com/rs/zerolog"
+
+	"github.com/Kyagara/equinox/v2/api"
 )
 
 // Limits in a route.
@@ -49,19 +51,37 @@ func NewLimit(limitType string) *Limit {
 	}
 }
 
-// Checks if any of the buckets provided are rate limited, and if so, blocks until the next reset.
+// Checks if any of the buckets provided are rate limited, and if so, blocks until the next reset, waiting on api.SystemClock.
 func (l *Limit) CheckBuckets(ctx context.Context, logger zerolog.Logger, route string) error {
+	return l.CheckBucketsWithClock(ctx, api.SystemClock{}, logger, route)
+}
+
+// Same as CheckBuckets, waiting on the given clock.
+func (l *Limit) CheckBucketsWithClock(ctx context.Context, clock api.Clock, logger zerolog.Logger, route string) error {
+	return l.checkBuckets(ctx, clock, logger, route, "", nil)
+}
+
+// Same as CheckBucketsWithClock, emits a RateLimitedEvent before waiting.
+func (l *Limit) checkBuckets(ctx context.Context, clock api.Clock, logger zerolog.Logger, route string, methodID string, listeners *api.Listeners) error {
 	l.mutex.Lock()
 	defer l.mutex.Unlock()
 
 	if l.RetryAfter > 0 {
+		listeners.EmitRateLimited(api.RateLimitedEvent{
+			Route:      route,
+			MethodID:   methodID,
+			LimitType:  l.Type,
+			Wait:       l.RetryAfter,
+			RetryAfter: true,
+		})
+
 		logger.Warn().
 			Str("route", route).
 			Str("type", l.Type).
 			Dur("wait", l.RetryAfter).
 			Msg("Rate limited, RetryAfter set")
 
-		err := WaitN(ctx, time.Now().Add(l.RetryAfter), l.RetryAfter)
+		err := WaitNWithClock(ctx, clock, clock.Now().Add(l.RetryAfter), l.RetryAfter)
 		if err != nil {
 			logger.Warn().Err(err).Msg("Failed to wait for retry after")
 			return err
@@ -76,7 +96,7 @@ func (l *Limit) CheckBuckets(ctx context.Context, logger zerolog.Logger, route s
 		bucket.mutex.Lock()
 
 		if bucket.IsRateLimited() {
-			wait := time.Until(bucket.Next)
+			wait := bucket.Next.Sub(clock.Now())
 			logger.Warn().
 				Str("route", route).
 				Str("type", l.Type).
@@ -84,7 +104,16 @@ func (l *Limit) CheckBuckets(ctx context.Context, logger zerolog.Logger, route s
 				Object("bucket", bucket).
 				Msg("Rate limited")
 
-			err := WaitN(ctx, bucket.Next, wait)
+			listeners.EmitRateLimited(api.RateLimitedEvent{
+				Route:     route,
+				MethodID:  methodID,
+				LimitType: l.Type,
+				Wait:      wait,
+				Limit:     bucket.Limit,
+				Interval:  bucket.Interval,
+			})
+
+			err := WaitNWithClock(ctx, clock, bucket.Next, wait)
 			if err != nil {
 				bucket.mutex.Unlock()
 				logger.Warn().Err(err).Msg("Failed to wait for reset")
@@ -128,6 +157,23 @@ func (l *Limit) LimitsMatch(limitHeader string) bool {
 	return true
 }
 
+func (l *Limit) changedEvent(route string, methodID string) api.LimitsChangedEvent {
+	buckets := make([]api.BucketLimit, 0, len(l.Buckets))
+	for _, bucket := range l.Buckets {
+		buckets = append(buckets, api.BucketLimit{
+			BaseLimit: bucket.BaseLimit,
+			Limit:     bucket.Limit,
+			Interval:  bucket.Interval,
+		})
+	}
+	return api.LimitsChangedEvent{
+		Route:     route,
+		MethodID:  methodID,
+		LimitType: l.Type,
+		Buckets:   buckets,
+	}
+}
+
 func (l *Limit) SetRetryAfter(delay time.Duration) {
 	l.mutex.Lock()
 	l.RetryAfter = delay
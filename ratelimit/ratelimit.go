@@ -10,6 +10,8 @@ import (
 	"time"
 
 	"github.com/rs/zerolog"
+
+	"github.com/Kyagara/equinox/v2/api"
 )
 
 const (
@@ -57,30 +59,55 @@ type RateLimit struct {
 	LimitUsageFactor float64
 	// Delay, in milliseconds, added to reset intervals.
 	IntervalOverhead time.Duration
-	Enabled          bool
+	// Receives the time waited in Reserve, set by the client from 'api.EquinoxConfig' if nil.
+	Metrics api.Metrics
+	// Receives rate limited and limits changed events from the internal store, set by the client from 'api.EquinoxConfig' if nil.
+	Listeners *api.Listeners
+	// Used for waits and bucket resets, set by the client from 'api.EquinoxConfig' if nil, api.SystemClock is used if both are nil.
+	Clock   api.Clock
+	Enabled bool
 }
 
 func NewInternalRateLimit(limitUsageFactor float64, intervalOverhead time.Duration) *RateLimit {
 	limitUsageFactor, intervalOverhead = ValidateRateLimitOptions(limitUsageFactor, intervalOverhead)
-	return &RateLimit{
-		store: &InternalRateLimitStore{
-			Route:            map[string]*Limits{},
-			limitUsageFactor: limitUsageFactor,
-			intervalOverhead: intervalOverhead,
-			mutex:            sync.Mutex{},
-		},
+	rateLimit := &RateLimit{
 		StoreType:        InternalRateLimit,
 		LimitUsageFactor: limitUsageFactor,
 		IntervalOverhead: intervalOverhead,
 		Enabled:          true,
 	}
+	rateLimit.store = &InternalRateLimitStore{
+		Route:            map[string]*Limits{},
+		limitUsageFactor: limitUsageFactor,
+		intervalOverhead: intervalOverhead,
+		owner:            rateLimit,
+		mutex:            sync.Mutex{},
+	}
+	return rateLimit
 }
 
 func (r *RateLimit) Reserve(ctx context.Context, logger zerolog.Logger, route string, methodID string, isRSO bool) error {
 	if !r.Enabled {
 		return ErrRateLimitIsDisabled
 	}
-	return r.store.Reserve(ctx, logger, route, methodID, isRSO)
+	if r.Metrics == nil {
+		return r.store.Reserve(ctx, logger, route, methodID, isRSO)
+	}
+
+	clock := r.clock()
+	start := clock.Now()
+	err := r.store.Reserve(ctx, logger, route, methodID, isRSO)
+	if err == nil {
+		r.Metrics.ObserveRateLimitWait(methodID, route, clock.Now().Sub(start))
+	}
+	return err
+}
+
+func (r *RateLimit) clock() api.Clock {
+	if r == nil || r.Clock == nil {
+		return api.SystemClock{}
+	}
+	return r.Clock
 }
 
 func (r *RateLimit) Update(ctx context.Context, logger zerolog.Logger, route string, methodID string, headers http.Header, retryAfter time.Duration) error {
@@ -90,8 +117,13 @@ func (r *RateLimit) Update(ctx context.Context, logger zerolog.Logger, route str
 	return r.store.Update(ctx, logger, route, methodID, headers, retryAfter)
 }
 
-// Parses the headers and returns a new Limit with its buckets.
+// Parses the headers and returns a new Limit with its buckets, using api.SystemClock for resets.
 func ParseHeaders(limitType string, limitHeader string, countHeader string, limitUsageFactor float64, intervalOverhead time.Duration) *Limit {
+	return ParseHeadersWithClock(api.SystemClock{}, limitType, limitHeader, countHeader, limitUsageFactor, intervalOverhead)
+}
+
+// Same as ParseHeaders, using the given clock for resets.
+func ParseHeadersWithClock(clock api.Clock, limitType string, limitHeader string, countHeader string, limitUsageFactor float64, intervalOverhead time.Duration) *Limit {
 	if limitHeader == "" || countHeader == "" {
 		return NewLimit(limitType)
 	}
@@ -110,7 +142,7 @@ func ParseHeaders(limitType string, limitHeader string, countHeader string, limi
 		baseLimit, interval := GetNumbersFromPair(limitString)
 		newLimit := int(math.Max(1, float64(baseLimit)*limitUsageFactor))
 		count, _ := GetNumbersFromPair(counts[i])
-		limit.Buckets[i] = NewBucket(interval, intervalOverhead, baseLimit, newLimit, count)
+		limit.Buckets[i] = NewBucketWithClock(clock, interval, intervalOverhead, baseLimit, newLimit, count)
 	}
 
 	return limit
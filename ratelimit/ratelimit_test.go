@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/Kyagara/equinox/v2/equinoxtest"
 	"github.com/Kyagara/equinox/v2/ratelimit"
 	"github.com/Kyagara/equinox/v2/test/util"
 	"github.com/stretchr/testify/require"
@@ -39,3 +40,31 @@ func TestParseHeaders(t *testing.T) {
 	require.NotEmpty(t, limit.Buckets)
 	require.Equal(t, ratelimit.METHOD_RATE_LIMIT_TYPE, limit.Type)
 }
+
+func TestParseHeadersWithClock(t *testing.T) {
+	t.Parallel()
+
+	clock := equinoxtest.NewClock(time.Now())
+	limit := ratelimit.ParseHeadersWithClock(clock, ratelimit.METHOD_RATE_LIMIT_TYPE, "2:10", "2:10", 1, 0)
+	require.Len(t, limit.Buckets, 1)
+	require.Equal(t, clock.Now().Add(10*time.Second), limit.Buckets[0].Next)
+
+	// Blocks on the fake clock until the bucket resets
+	done := make(chan error)
+	go func() {
+		done <- limit.CheckBucketsWithClock(context.Background(), clock, util.NewTestLogger(), "route")
+	}()
+
+	require.Eventually(t, func() bool { return clock.Waiters() == 1 }, time.Second, time.Millisecond)
+	select {
+	case <-done:
+		t.Fatal("CheckBucketsWithClock returned before the reset")
+	default:
+	}
+
+	clock.Advance(10 * time.Second)
+	require.NoError(t, <-done)
+
+	bucket := ratelimit.NewBucketWithClock(clock, time.Second, 0, 10, 10, 0)
+	require.Equal(t, clock.Now().Add(time.Second), bucket.Next)
+}
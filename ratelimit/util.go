@@ -5,6 +5,8 @@ import (
 	"strconv"
 	"strings"
 	"time"
+
+	"github.com/Kyagara/equinox/v2/api"
 )
 
 // Checks if the limit usage factor and interval overhead within a valid range.
@@ -22,13 +24,18 @@ func ValidateRateLimitOptions(limitUsageFactor float64, intervalOverhead time.Du
 
 // Waits for the given duration after checking if the context deadline will be exceeded.
 func WaitN(ctx context.Context, estimated time.Time, duration time.Duration) error {
+	return WaitNWithClock(ctx, api.SystemClock{}, estimated, duration)
+}
+
+// Same as WaitN, waiting on the given clock.
+func WaitNWithClock(ctx context.Context, clock api.Clock, estimated time.Time, duration time.Duration) error {
 	deadline, ok := ctx.Deadline()
 	if ok && deadline.Before(estimated) {
 		return ErrContextDeadlineExceeded
 	}
 
 	select {
-	case <-time.After(duration):
+	case <-clock.After(duration):
 	case <-ctx.Done():
 		return ctx.Err()
 	}
@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/Kyagara/equinox/v2/equinoxtest"
 	"github.com/Kyagara/equinox/v2/ratelimit"
 	"github.com/stretchr/testify/require"
 )
@@ -28,12 +29,21 @@ func TestWaitN(t *testing.T) {
 	t.Run("deadline not exceeded", func(t *testing.T) {
 		t.Parallel()
 
+		clock := equinoxtest.NewClock(time.Now())
 		ctx := context.Background()
-		estimated := time.Now().Add(time.Second)
+		estimated := clock.Now().Add(time.Second)
 		duration := 2 * time.Second
 
-		err := ratelimit.WaitN(ctx, estimated, duration)
-		require.NoError(t, err)
+		errs := make(chan error, 1)
+		go func() {
+			errs <- ratelimit.WaitNWithClock(ctx, clock, estimated, duration)
+		}()
+
+		require.Eventually(t, func() bool { return clock.Waiters() == 1 }, time.Second, time.Millisecond)
+		clock.Advance(time.Second)
+		require.Equal(t, 1, clock.Waiters())
+		clock.Advance(time.Second)
+		require.NoError(t, <-errs)
 	})
 
 	t.Run("deadline exceeded", func(t *testing.T) {
@@ -52,17 +62,19 @@ func TestWaitN(t *testing.T) {
 	t.Run("context canceled", func(t *testing.T) {
 		t.Parallel()
 
+		clock := equinoxtest.NewClock(time.Now())
 		ctx := context.Background()
 		ctx, cancel := context.WithCancel(ctx)
-		estimated := time.Now().Add(10 * time.Second)
+		estimated := clock.Now().Add(10 * time.Second)
 		duration := 5 * time.Second
 
+		errs := make(chan error, 1)
 		go func() {
-			time.Sleep(2 * time.Second)
-			cancel()
+			errs <- ratelimit.WaitNWithClock(ctx, clock, estimated, duration)
 		}()
 
-		err := ratelimit.WaitN(ctx, estimated, duration)
-		require.Equal(t, context.Canceled, err)
+		require.Eventually(t, func() bool { return clock.Waiters() == 1 }, time.Second, time.Millisecond)
+		cancel()
+		require.Equal(t, context.Canceled, <-errs)
 	})
 }
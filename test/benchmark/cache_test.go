@@ -20,7 +20,7 @@ func BenchmarkCacheDisabledSummonerByPUUID(b *testing.B) {
 	defer httpmock.DeactivateAndReset()
 
 	httpmock.RegisterResponder("GET", "https://kr.api.riotgames.com/lol/summoner/v4/summoners/by-puuid/puuid",
-		httpmock.NewJsonResponderOrPanic(200, httpmock.File("../data/summoner.json")))
+		httpmock.NewJsonResponderOrPanic(200, httpmock.File("../data/summoner-v4.getByPUUID.json")))
 
 	client := util.NewBenchmarkEquinoxClient(b)
 
@@ -44,7 +44,7 @@ func BenchmarkCacheBigCacheSummonerByPUUID(b *testing.B) {
 	defer httpmock.DeactivateAndReset()
 
 	httpmock.RegisterResponder("GET", "https://kr.api.riotgames.com/lol/summoner/v4/summoners/by-puuid/puuid",
-		httpmock.NewJsonResponderOrPanic(200, httpmock.File("../data/summoner.json")))
+		httpmock.NewJsonResponderOrPanic(200, httpmock.File("../data/summoner-v4.getByPUUID.json")))
 
 	config := equinox.DefaultConfig("RGAPI-TEST")
 	cache, err := equinox.DefaultCache()
@@ -76,7 +76,7 @@ func BenchmarkCacheRedisSummonerByPUUID(b *testing.B) {
 	defer httpmock.DeactivateAndReset()
 
 	httpmock.RegisterResponder("GET", "https://kr.api.riotgames.com/lol/summoner/v4/summoners/by-puuid/puuid",
-		httpmock.NewJsonResponderOrPanic(200, httpmock.File("../data/summoner.json")))
+		httpmock.NewJsonResponderOrPanic(200, httpmock.File("../data/summoner-v4.getByPUUID.json")))
 
 	client := util.NewBenchmarkRedisCacheEquinoxClient(b)
 
@@ -16,7 +16,7 @@ func BenchmarkDataMatchByID(b *testing.B) {
 	defer httpmock.DeactivateAndReset()
 
 	httpmock.RegisterResponder("GET", "https://asia.api.riotgames.com/lol/match/v5/matches/KR_7014499581",
-		httpmock.NewJsonResponderOrPanic(200, httpmock.File("../data/match.json")))
+		httpmock.NewJsonResponderOrPanic(200, httpmock.File("../data/match-v5.getMatch.json")))
 
 	client := util.NewBenchmarkEquinoxClient(b)
 
@@ -40,7 +40,7 @@ func BenchmarkDataMatchTimeline(b *testing.B) {
 	defer httpmock.DeactivateAndReset()
 
 	httpmock.RegisterResponder("GET", "https://asia.api.riotgames.com/lol/match/v5/matches/KR_7014499581/timeline",
-		httpmock.NewJsonResponderOrPanic(200, httpmock.File("../data/match.timeline.json")))
+		httpmock.NewJsonResponderOrPanic(200, httpmock.File("../data/match-v5.getTimeline.json")))
 
 	client := util.NewBenchmarkEquinoxClient(b)
 
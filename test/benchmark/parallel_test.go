@@ -21,7 +21,7 @@ func BenchmarkParallelTestRateLimit(b *testing.B) {
 	defer httpmock.DeactivateAndReset()
 
 	httpmock.RegisterResponder("GET", "https://kr.api.riotgames.com/lol/summoner/v4/summoners/by-puuid/puuid",
-		httpmock.NewJsonResponderOrPanic(200, httpmock.File("../data/summoner.json")).
+		httpmock.NewJsonResponderOrPanic(200, httpmock.File("../data/summoner-v4.getByPUUID.json")).
 			HeaderSet(http.Header{
 				ratelimit.APP_RATE_LIMIT_HEADER:          {"20:1,40:4"},
 				ratelimit.APP_RATE_LIMIT_COUNT_HEADER:    {"1:1,1:4"},
@@ -58,7 +58,7 @@ func BenchmarkParallelSummonerByPUUID(b *testing.B) {
 	defer httpmock.DeactivateAndReset()
 
 	httpmock.RegisterResponder("GET", "https://kr.api.riotgames.com/lol/summoner/v4/summoners/by-puuid/puuid",
-		httpmock.NewJsonResponderOrPanic(200, httpmock.File("../data/summoner.json")))
+		httpmock.NewJsonResponderOrPanic(200, httpmock.File("../data/summoner-v4.getByPUUID.json")))
 
 	client := util.NewBenchmarkEquinoxClient(b)
 
@@ -84,7 +84,7 @@ func BenchmarkParallelRedisCachedSummonerByPUUID(b *testing.B) {
 	defer httpmock.DeactivateAndReset()
 
 	httpmock.RegisterResponder("GET", "https://kr.api.riotgames.com/lol/summoner/v4/summoners/by-puuid/puuid",
-		httpmock.NewJsonResponderOrPanic(200, httpmock.File("../data/summoner.json")))
+		httpmock.NewJsonResponderOrPanic(200, httpmock.File("../data/summoner-v4.getByPUUID.json")))
 
 	client := util.NewBenchmarkRedisCacheEquinoxClient(b)
 
@@ -111,7 +111,7 @@ func BenchmarkParallelSummonerByAccessToken(b *testing.B) {
 	defer httpmock.DeactivateAndReset()
 
 	httpmock.RegisterResponder("GET", "https://kr.api.riotgames.com/lol/summoner/v4/summoners/me",
-		httpmock.NewJsonResponderOrPanic(200, httpmock.File("../data/summoner.json")))
+		httpmock.NewJsonResponderOrPanic(200, httpmock.File("../data/summoner-v4.getByPUUID.json")))
 
 	client := util.NewBenchmarkEquinoxClient(b)
 
@@ -138,7 +138,7 @@ func BenchmarkParallelMatchListByPUUID(b *testing.B) {
 	defer httpmock.DeactivateAndReset()
 
 	httpmock.RegisterResponder("GET", "https://asia.api.riotgames.com/lol/match/v5/matches/by-puuid/puuid/ids?count=20&queue=420&type=ranked",
-		httpmock.NewJsonResponderOrPanic(200, httpmock.File("../data/match.list.json")))
+		httpmock.NewJsonResponderOrPanic(200, httpmock.File("../data/match-v5.getMatchIdsByPUUID.json")))
 
 	client := util.NewBenchmarkEquinoxClient(b)
 
@@ -157,3 +157,35 @@ func BenchmarkParallelMatchListByPUUID(b *testing.B) {
 		}
 	})
 }
+
+// Same as BenchmarkParallelMatchListByPUUID, using the options struct.
+func BenchmarkParallelMatchListByPUUIDWithOptions(b *testing.B) {
+	b.ReportAllocs()
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	httpmock.RegisterResponder("GET", "https://asia.api.riotgames.com/lol/match/v5/matches/by-puuid/puuid/ids?count=20&queue=420&type=ranked",
+		httpmock.NewJsonResponderOrPanic(200, httpmock.File("../data/match-v5.getMatchIdsByPUUID.json")))
+
+	client := util.NewBenchmarkEquinoxClient(b)
+
+	ctx := context.Background()
+
+	queue := lol.SUMMONERS_RIFT_5V5_RANKED_SOLO_QUEUE
+	matchType := "ranked"
+	count := 20
+	opts := &lol.MatchV5ListByPUUIDOptions{Queue: &queue, Type: &matchType, Count: &count}
+
+	b.ResetTimer()
+	b.RunParallel(func(pb *testing.PB) {
+		for pb.Next() {
+			data, err := client.LOL.MatchV5.ListByPUUIDWithOptions(ctx, api.ASIA, "puuid", opts)
+			if err != nil {
+				b.Fatal(err)
+			}
+			if data[0] != "KR_7050905124" {
+				b.Fatalf("data[0] != KR_7050905124, got %s", data[0])
+			}
+		}
+	})
+}
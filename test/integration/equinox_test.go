@@ -4,22 +4,57 @@
 package integration
 
 import (
+	"net/http"
 	"os"
+	"testing"
 	"time"
 
 	"github.com/Kyagara/equinox/v2"
 	"github.com/Kyagara/equinox/v2/api"
+	"github.com/Kyagara/equinox/v2/equinoxtest"
 	"github.com/rs/zerolog"
 )
 
 var (
-	client *equinox.Equinox
+	client   *equinox.Equinox
+	recorder *equinoxtest.Recorder
 )
 
+func TestMain(m *testing.M) {
+	code := m.Run()
+	if recorder != nil {
+		if err := recorder.Save(); err != nil {
+			panic(err)
+		}
+	}
+	os.Exit(code)
+}
+
+// Replayed when RIOT_GAMES_API_KEY is not set, record it again by setting both RIOT_GAMES_API_KEY and EQUINOX_CASSETTE.
+const defaultCassette = "testdata/cassette.json"
+
 func init() {
 	key := os.Getenv("RIOT_GAMES_API_KEY")
-	if key == "" {
-		panic("RIOT_GAMES_API_KEY not set")
+	cassette := os.Getenv("EQUINOX_CASSETTE")
+
+	var httpClient *http.Client
+	if key == "" || cassette != "" {
+		// Record with a key, replay without one
+		mode := equinoxtest.RecordMode
+		if key == "" {
+			mode = equinoxtest.ReplayMode
+			key = "RGAPI-REPLAY"
+			if cassette == "" {
+				cassette = defaultCassette
+			}
+		}
+
+		var err error
+		recorder, err = equinoxtest.NewRecorder(mode, cassette, nil)
+		if err != nil {
+			panic(err)
+		}
+		httpClient = recorder.HTTPClient()
 	}
 
 	// Default client with pretty logging and lower MaxRetries
@@ -34,7 +69,7 @@ func init() {
 
 	ratelimit := equinox.DefaultRateLimit()
 
-	client, err = equinox.NewCustomClient(config, nil, cache, ratelimit)
+	client, err = equinox.NewCustomClient(config, httpClient, cache, ratelimit)
 	if err != nil {
 		panic(err)
 	}
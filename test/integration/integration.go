@@ -1,2 +1,2 @@
-// This package only contains integration tests and are meant to be run manually.
+// This package only contains integration tests, replayed from testdata/cassette.json unless RIOT_GAMES_API_KEY is set.
 package integration
@@ -0,0 +1,54 @@
+//go:build integration
+// +build integration
+
+package integration
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Kyagara/equinox/v2/api"
+	"github.com/Kyagara/equinox/v2/clients/lol"
+	jsonv2 "github.com/go-json-experiment/json"
+	"github.com/stretchr/testify/require"
+)
+
+// Records the sample responses in 'test/data' again, requires RIOT_GAMES_API_KEY and EQUINOX_SAMPLES set to the directory, such as '../data'.
+//
+// Samples are written as returned by the API, the player is taken from the KR challenger ladder so the match is recent.
+func TestRecordSamples(t *testing.T) {
+	dir := os.Getenv("EQUINOX_SAMPLES")
+	if dir == "" || os.Getenv("RIOT_GAMES_API_KEY") == "" {
+		t.Skip("EQUINOX_SAMPLES and RIOT_GAMES_API_KEY are required to record samples")
+	}
+
+	// Never read from the cache, samples must be current
+	ctx := context.WithValue(context.Background(), api.Revalidate, true)
+	league, err := client.LOL.LeagueV4.ChallengerByQueue(ctx, lol.KR, lol.RANKED_SOLO_5X5_QUEUETYPE)
+	require.NoError(t, err)
+	require.NotEmpty(t, league.Entries, "expecting challenger entries")
+	puuid := league.Entries[0].PUUID
+
+	record := func(methodID string, urlComponents ...string) []byte {
+		logger := client.Internal.Logger("RecordSamples")
+		request, err := client.Internal.Request(ctx, logger, http.MethodGet, urlComponents, methodID, nil)
+		require.NoError(t, err)
+		data, err := client.Internal.ExecuteBytes(ctx, request)
+		require.NoError(t, err)
+		require.NoError(t, os.WriteFile(filepath.Join(dir, methodID+".json"), data, 0o644))
+		return data
+	}
+
+	record("summoner-v4.getByPUUID", "https://", lol.KR.String(), api.RIOT_API_BASE_URL_FORMAT, "/lol/summoner/v4/summoners/by-puuid/", puuid)
+	data := record("match-v5.getMatchIdsByPUUID", "https://", api.ASIA.String(), api.RIOT_API_BASE_URL_FORMAT, "/lol/match/v5/matches/by-puuid/", puuid, "/ids")
+
+	var ids []string
+	require.NoError(t, jsonv2.Unmarshal(data, &ids))
+	require.NotEmpty(t, ids, "expecting a recent match")
+
+	record("match-v5.getMatch", "https://", api.ASIA.String(), api.RIOT_API_BASE_URL_FORMAT, "/lol/match/v5/matches/", ids[0])
+	record("match-v5.getTimeline", "https://", api.ASIA.String(), api.RIOT_API_BASE_URL_FORMAT, "/lol/match/v5/matches/", ids[0], "/timeline")
+}
@@ -3,6 +3,9 @@ package util
 import (
 	"context"
 	"os"
+	"reflect"
+	"sort"
+	"strings"
 	"testing"
 	"time"
 
@@ -10,6 +13,7 @@ import (
 	"github.com/Kyagara/equinox/v2/api"
 	"github.com/Kyagara/equinox/v2/cache"
 	"github.com/Kyagara/equinox/v2/internal"
+	jsonv2 "github.com/go-json-experiment/json"
 	"github.com/redis/go-redis/v9"
 	"github.com/rs/zerolog"
 )
@@ -73,3 +77,82 @@ func NewBenchmarkRedisCacheEquinoxClient(b *testing.B) *equinox.Equinox {
 func NewTestLogger() zerolog.Logger {
 	return zerolog.New(zerolog.ConsoleWriter{Out: os.Stderr}).Level(zerolog.TraceLevel)
 }
+
+// Returns the fields of value's type that are not in any object of the sample, such as '/info/participants/*/missions'.
+//
+// Array indices and map keys are replaced by '*'. Catches fields in a model that the API doesn't return, or returns with another name.
+func MissingMembers(sample []byte, value any) ([]string, error) {
+	var data any
+	if err := jsonv2.Unmarshal(sample, &data); err != nil {
+		return nil, err
+	}
+
+	missing := missingMembers("", reflect.TypeOf(value), []any{data})
+	sort.Strings(missing)
+	return missing, nil
+}
+
+// Walks the type with every value found at the pointer in the sample.
+func missingMembers(pointer string, typ reflect.Type, values []any) []string {
+	for typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+
+	var missing []string
+	switch typ.Kind() {
+	case reflect.Struct:
+		var objects []map[string]any
+		for _, value := range values {
+			if object, ok := value.(map[string]any); ok {
+				objects = append(objects, object)
+			}
+		}
+		if len(objects) == 0 {
+			return nil
+		}
+
+		for i := range typ.NumField() {
+			field := typ.Field(i)
+			name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+			if !field.IsExported() || name == "-" {
+				continue
+			}
+			if name == "" {
+				name = field.Name
+			}
+
+			var members []any
+			for _, object := range objects {
+				if member, ok := object[name]; ok {
+					members = append(members, member)
+				}
+			}
+
+			member := pointer + "/" + name
+			if len(members) == 0 {
+				missing = append(missing, member)
+				continue
+			}
+			missing = append(missing, missingMembers(member, field.Type, members)...)
+		}
+	case reflect.Slice, reflect.Array:
+		var elements []any
+		for _, value := range values {
+			if array, ok := value.([]any); ok {
+				elements = append(elements, array...)
+			}
+		}
+		missing = missingMembers(pointer+"/*", typ.Elem(), elements)
+	case reflect.Map:
+		var elements []any
+		for _, value := range values {
+			if object, ok := value.(map[string]any); ok {
+				for _, element := range object {
+					elements = append(elements, element)
+				}
+			}
+		}
+		missing = missingMembers(pointer+"/*", typ.Elem(), elements)
+	}
+	return missing
+}
@@ -0,0 +1,322 @@
+// This package polls the League of Legends spectator endpoint for a set of players and emits events when their games start and end.
+package watcher
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/Kyagara/equinox/v2"
+	"github.com/Kyagara/equinox/v2/api"
+	"github.com/Kyagara/equinox/v2/clients/lol"
+)
+
+// Returned by Run if it was called before, the events channel is closed after the first Run returns.
+var ErrAlreadyRun = errors.New("watcher already run")
+
+const (
+	// Fraction of the spectator rate learned by the rate limiter used by default.
+	DEFAULT_RATE_SHARE = 0.5
+
+	spectatorMethodID = "spectator-v5.getCurrentGameInfoByPuuid"
+	// Requests per second while the rate limiter has no limits for the spectator method, e.g. before the first response.
+	fallbackRate = 1.0
+)
+
+type EventType int
+
+const (
+	// A tracked player entered a game.
+	GameStarted EventType = iota
+	// A tracked player is no longer in the game from the previous poll, Game is the last state seen.
+	GameEnded
+	// The match of an ended game is available in match-v5, or Err is set if it couldn't be fetched.
+	MatchResolved
+	// Polling a player failed with an error other than api.ErrNotFound.
+	PollFailed
+)
+
+func (t EventType) String() string {
+	switch t {
+	case GameStarted:
+		return "GameStarted"
+	case GameEnded:
+		return "GameEnded"
+	case MatchResolved:
+		return "MatchResolved"
+	case PollFailed:
+		return "PollFailed"
+	default:
+		return "Unknown"
+	}
+}
+
+type Event struct {
+	Type     EventType
+	PUUID    string
+	Platform lol.PlatformRoute
+	// Set for GameStarted and GameEnded.
+	Game *lol.SpectatorCurrentGameInfoV5DTO
+	// Match ID of the game, e.g. 'EUW1_1234'. Set for every event except PollFailed.
+	MatchID string
+	// Set for MatchResolved if the match was fetched.
+	Match *lol.MatchV5DTO
+	Err   error
+}
+
+// Watcher configuration object.
+type Options struct {
+	// Maximum number of spectator requests per second across all players, 0 only uses RateShare.
+	RequestsPerSecond float64
+	// Fraction of the spectator method and app rate learned by the rate limiter used for polling, defaults to DEFAULT_RATE_SHARE.
+	//
+	// The remaining budget is left for other requests. Until limits are received, players are polled once per second.
+	RateShare float64
+	// Minimum time between polls of the same player, defaults to 30 seconds.
+	MinInterval time.Duration
+	// Fetches the MatchV5DTO after a game ends, emitting a MatchResolved event.
+	ResolveMatch bool
+	// Time between match-v5 requests while it returns api.ErrNotFound, defaults to 30 seconds.
+	ResolveInterval time.Duration
+	// Number of match-v5 requests before giving up, defaults to 20.
+	ResolveAttempts int
+	// Size of the events channel buffer, defaults to 64.
+	BufferSize int
+}
+
+type Watcher struct {
+	client  *equinox.Equinox
+	clock   api.Clock
+	opts    Options
+	events  chan Event
+	started atomic.Bool
+	mutex   sync.Mutex
+	players map[string]*player
+	// Round-robin order of the tracked players.
+	order []string
+	next  int
+}
+
+type player struct {
+	platform lol.PlatformRoute
+	game     *lol.SpectatorCurrentGameInfoV5DTO
+	lastPoll time.Time
+}
+
+// Creates a new Watcher, opts can be nil.
+func New(client *equinox.Equinox, opts *Options) *Watcher {
+	o := Options{}
+	if opts != nil {
+		o = *opts
+	}
+	if o.RateShare <= 0 || o.RateShare > 1 {
+		o.RateShare = DEFAULT_RATE_SHARE
+	}
+	if o.MinInterval <= 0 {
+		o.MinInterval = 30 * time.Second
+	}
+	if o.ResolveInterval <= 0 {
+		o.ResolveInterval = 30 * time.Second
+	}
+	if o.ResolveAttempts <= 0 {
+		o.ResolveAttempts = 20
+	}
+	if o.BufferSize <= 0 {
+		o.BufferSize = 64
+	}
+
+	return &Watcher{
+		client:  client,
+		clock:   client.Internal.Clock(),
+		opts:    o,
+		events:  make(chan Event, o.BufferSize),
+		players: map[string]*player{},
+	}
+}
+
+// Channel of events, closed when the first Run returns.
+func (w *Watcher) Events() <-chan Event {
+	return w.events
+}
+
+// Starts tracking a player, does nothing if the player is already tracked.
+func (w *Watcher) Add(puuid string, platform lol.PlatformRoute) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	if _, ok := w.players[puuid]; ok {
+		return
+	}
+	w.players[puuid] = &player{platform: platform}
+	w.order = append(w.order, puuid)
+}
+
+// Stops tracking a player, no GameEnded event is emitted.
+func (w *Watcher) Remove(puuid string) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	delete(w.players, puuid)
+	for i, id := range w.order {
+		if id == puuid {
+			w.order = append(w.order[:i], w.order[i+1:]...)
+			if w.next > i {
+				w.next--
+			}
+			break
+		}
+	}
+}
+
+// Polls the tracked players until the context is cancelled, at the rate allowed by the rate limiter, see Options.RateShare.
+//
+// Players are polled in order, skipping those polled less than MinInterval ago. The events channel is closed
+// after all pending match resolutions return. Returns ErrAlreadyRun if called more than once.
+func (w *Watcher) Run(ctx context.Context) error {
+	if !w.started.CompareAndSwap(false, true) {
+		return ErrAlreadyRun
+	}
+
+	var wg sync.WaitGroup
+	defer func() {
+		wg.Wait()
+		close(w.events)
+	}()
+
+	var delay time.Duration
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-w.clock.After(delay):
+		}
+
+		puuid, p := w.nextPlayer(w.clock.Now())
+		if p == nil {
+			delay = w.interval("")
+			continue
+		}
+		delay = w.interval(p.platform.String())
+
+		ended, ok := w.poll(ctx, puuid, p)
+		if ok && ended != nil && w.opts.ResolveMatch {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				w.resolve(ctx, puuid, p.platform, ended)
+			}()
+		}
+	}
+}
+
+// Returns the time until the next poll in the route, waiting for a reset if the spectator method or app limit has no requests left.
+func (w *Watcher) interval(route string) time.Duration {
+	budget := w.client.Internal.Budget(route, spectatorMethodID)
+	if budget.Remaining == 0 {
+		return budget.Reset
+	}
+
+	rate := w.opts.RequestsPerSecond
+	if learned := budget.Rate * w.opts.RateShare; learned > 0 && (rate <= 0 || learned < rate) {
+		rate = learned
+	}
+	if rate <= 0 {
+		rate = fallbackRate
+	}
+	return time.Duration(float64(time.Second) / rate)
+}
+
+// Returns the next player due for a poll, nil if none.
+func (w *Watcher) nextPlayer(now time.Time) (string, *player) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	for range w.order {
+		if w.next >= len(w.order) {
+			w.next = 0
+		}
+		puuid := w.order[w.next]
+		w.next++
+
+		p := w.players[puuid]
+		if now.Sub(p.lastPoll) >= w.opts.MinInterval {
+			p.lastPoll = now
+			return puuid, p
+		}
+	}
+	return "", nil
+}
+
+// Polls a player and emits the events, returns the game that ended, if any.
+func (w *Watcher) poll(ctx context.Context, puuid string, p *player) (*lol.SpectatorCurrentGameInfoV5DTO, bool) {
+	// A cached game or 404 would delay the events by the cache TTL
+	game, err := w.client.LOL.SpectatorV5.CurrentGameInfoByPUUID(context.WithValue(ctx, api.Revalidate, true), p.platform, puuid)
+	if err != nil && !errors.Is(err, api.ErrNotFound) {
+		if ctx.Err() != nil {
+			return nil, false
+		}
+		return nil, w.emit(ctx, Event{Type: PollFailed, PUUID: puuid, Platform: p.platform, Err: err})
+	}
+
+	w.mutex.Lock()
+	previous := p.game
+	p.game = game
+	w.mutex.Unlock()
+
+	var ended *lol.SpectatorCurrentGameInfoV5DTO
+	if previous != nil && (game == nil || game.GameID != previous.GameID) {
+		ended = previous
+		if !w.emit(ctx, Event{Type: GameEnded, PUUID: puuid, Platform: p.platform, Game: previous, MatchID: MatchID(previous)}) {
+			return nil, false
+		}
+	}
+
+	if game != nil && (previous == nil || game.GameID != previous.GameID) {
+		if !w.emit(ctx, Event{Type: GameStarted, PUUID: puuid, Platform: p.platform, Game: game, MatchID: MatchID(game)}) {
+			return nil, false
+		}
+	}
+
+	return ended, true
+}
+
+// Fetches the match of an ended game, retrying while match-v5 returns api.ErrNotFound.
+func (w *Watcher) resolve(ctx context.Context, puuid string, platform lol.PlatformRoute, game *lol.SpectatorCurrentGameInfoV5DTO) {
+	matchID := MatchID(game)
+	event := Event{Type: MatchResolved, PUUID: puuid, Platform: platform, MatchID: matchID}
+
+	// A cached 404 would make every attempt return api.ErrNotFound
+	revalidate := context.WithValue(ctx, api.Revalidate, true)
+	for attempt := 1; ; attempt++ {
+		match, err := w.client.LOL.MatchV5.ByID(revalidate, platform.ToRegional(), matchID)
+		if err == nil || !errors.Is(err, api.ErrNotFound) || attempt >= w.opts.ResolveAttempts {
+			event.Match, event.Err = match, err
+			break
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-w.clock.After(w.opts.ResolveInterval):
+		}
+	}
+
+	w.emit(ctx, event)
+}
+
+func (w *Watcher) emit(ctx context.Context, event Event) bool {
+	select {
+	case <-ctx.Done():
+		return false
+	case w.events <- event:
+		return true
+	}
+}
+
+// Returns the match-v5 ID of a spectator game, e.g. 'EUW1_1234'.
+func MatchID(game *lol.SpectatorCurrentGameInfoV5DTO) string {
+	return game.PlatformID + "_" + strconv.Itoa(game.GameID)
+}
@@ -0,0 +1,210 @@
+package watcher_test
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Kyagara/equinox/v2"
+	"github.com/Kyagara/equinox/v2/cache"
+	"github.com/Kyagara/equinox/v2/clients/lol"
+	"github.com/Kyagara/equinox/v2/equinoxtest"
+	"github.com/Kyagara/equinox/v2/ratelimit"
+	"github.com/Kyagara/equinox/v2/test/util"
+	"github.com/Kyagara/equinox/v2/watcher"
+	"github.com/allegro/bigcache/v3"
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWatcher(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	// In game for the first two polls, then not in game
+	var polls atomic.Int32
+	httpmock.RegisterResponder("GET", "https://euw1.api.riotgames.com/lol/spectator/v5/active-games/by-summoner/puuid",
+		func(req *http.Request) (*http.Response, error) {
+			if polls.Add(1) <= 2 {
+				return httpmock.NewStringResponse(200, `{"gameId":1234,"platformId":"EUW1"}`), nil
+			}
+			return httpmock.NewStringResponse(404, `{}`), nil
+		})
+
+	// Not available right after the game ends
+	var attempts atomic.Int32
+	httpmock.RegisterResponder("GET", "https://europe.api.riotgames.com/lol/match/v5/matches/EUW1_1234",
+		func(req *http.Request) (*http.Response, error) {
+			if attempts.Add(1) == 1 {
+				return httpmock.NewStringResponse(404, `{}`), nil
+			}
+			return httpmock.NewStringResponse(200, `{"metadata":{"matchId":"EUW1_1234"}}`), nil
+		})
+
+	config := util.NewTestEquinoxConfig()
+	client, err := equinox.NewCustomClient(config, nil, nil, nil)
+	require.NoError(t, err)
+
+	w := watcher.New(client, &watcher.Options{
+		RequestsPerSecond: 1000,
+		MinInterval:       time.Millisecond,
+		ResolveMatch:      true,
+		ResolveInterval:   time.Millisecond,
+	})
+	w.Add("puuid", lol.EUW1)
+	w.Add("puuid", lol.EUW1)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	done := make(chan error)
+	go func() {
+		done <- w.Run(ctx)
+	}()
+
+	events := []watcher.Event{}
+	for event := range w.Events() {
+		events = append(events, event)
+		if event.Type == watcher.MatchResolved {
+			cancel()
+		}
+	}
+	require.ErrorIs(t, <-done, context.Canceled)
+
+	require.Len(t, events, 3)
+	require.Equal(t, watcher.GameStarted, events[0].Type)
+	require.Equal(t, "EUW1_1234", events[0].MatchID)
+	require.Equal(t, watcher.GameEnded, events[1].Type)
+	require.Equal(t, 1234, events[1].Game.GameID)
+	require.Equal(t, watcher.MatchResolved, events[2].Type)
+	require.NoError(t, events[2].Err)
+	require.Equal(t, "EUW1_1234", events[2].Match.Metadata.MatchID)
+	require.Equal(t, int32(2), attempts.Load())
+
+	w.Remove("puuid")
+}
+
+func TestWatcherCache(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	// In game for the first poll, then not in game
+	var polls atomic.Int32
+	httpmock.RegisterResponder("GET", "https://euw1.api.riotgames.com/lol/spectator/v5/active-games/by-summoner/puuid",
+		func(req *http.Request) (*http.Response, error) {
+			if polls.Add(1) == 1 {
+				return httpmock.NewStringResponse(200, `{"gameId":1234,"platformId":"EUW1"}`), nil
+			}
+			return httpmock.NewStringResponse(404, `{}`), nil
+		})
+
+	var attempts atomic.Int32
+	httpmock.RegisterResponder("GET", "https://europe.api.riotgames.com/lol/match/v5/matches/EUW1_1234",
+		func(req *http.Request) (*http.Response, error) {
+			if attempts.Add(1) == 1 {
+				return httpmock.NewStringResponse(404, `{}`), nil
+			}
+			return httpmock.NewStringResponse(200, `{"metadata":{"matchId":"EUW1_1234"}}`), nil
+		})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// Responses and 404s are cached for longer than the test, GameEnded and MatchResolved are only emitted if the cache is skipped
+	c, err := cache.NewBigCache(ctx, bigcache.DefaultConfig(4*time.Minute))
+	require.NoError(t, err)
+	c.NotFoundTTL = time.Minute
+
+	config := util.NewTestEquinoxConfig()
+	client, err := equinox.NewCustomClient(config, nil, c, nil)
+	require.NoError(t, err)
+
+	w := watcher.New(client, &watcher.Options{
+		RequestsPerSecond: 1000,
+		MinInterval:       time.Millisecond,
+		ResolveMatch:      true,
+		ResolveInterval:   time.Millisecond,
+	})
+	w.Add("puuid", lol.EUW1)
+
+	done := make(chan error)
+	go func() {
+		done <- w.Run(ctx)
+	}()
+
+	events := []watcher.Event{}
+	for event := range w.Events() {
+		events = append(events, event)
+		if event.Type == watcher.MatchResolved {
+			cancel()
+		}
+	}
+	require.ErrorIs(t, <-done, context.Canceled)
+
+	require.Len(t, events, 3)
+	require.Equal(t, watcher.GameStarted, events[0].Type)
+	require.Equal(t, watcher.GameEnded, events[1].Type)
+	require.Equal(t, watcher.MatchResolved, events[2].Type)
+	require.NoError(t, events[2].Err)
+	require.Equal(t, int32(2), attempts.Load())
+}
+
+func TestWatcherRate(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	var polls atomic.Int32
+	httpmock.RegisterResponder("GET", "https://euw1.api.riotgames.com/lol/spectator/v5/active-games/by-summoner/puuid",
+		func(req *http.Request) (*http.Response, error) {
+			polls.Add(1)
+			res := httpmock.NewStringResponse(404, `{}`)
+			res.Header.Set(ratelimit.METHOD_RATE_LIMIT_HEADER, "40:10")
+			res.Header.Set(ratelimit.METHOD_RATE_LIMIT_COUNT_HEADER, "1:10")
+			return res, nil
+		})
+
+	clock := equinoxtest.NewClock(time.Now())
+	config := util.NewTestEquinoxConfig()
+	config.Clock = clock
+	client, err := equinox.NewCustomClient(config, nil, nil, ratelimit.NewInternalRateLimit(1, 0))
+	require.NoError(t, err)
+
+	w := watcher.New(client, &watcher.Options{MinInterval: time.Millisecond})
+	w.Add("puuid", lol.EUW1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	done := make(chan error)
+	go func() {
+		done <- w.Run(ctx)
+	}()
+
+	waitPoll := func(n int32) {
+		require.Eventually(t, func() bool {
+			return polls.Load() == n && clock.Waiters() == 1
+		}, time.Second, time.Millisecond)
+	}
+
+	// No limits before the first response, polled once per second
+	waitPoll(1)
+	clock.Advance(999 * time.Millisecond)
+	require.Equal(t, int32(1), polls.Load())
+	clock.Advance(time.Millisecond)
+
+	// 40 requests every 10 seconds, half of it is used by default
+	waitPoll(2)
+	clock.Advance(499 * time.Millisecond)
+	require.Equal(t, int32(2), polls.Load())
+	clock.Advance(time.Millisecond)
+	waitPoll(3)
+
+	cancel()
+	require.ErrorIs(t, <-done, context.Canceled)
+	for range w.Events() {
+	}
+
+	require.ErrorIs(t, w.Run(context.Background()), watcher.ErrAlreadyRun)
+}